@@ -7,7 +7,9 @@ import (
 )
 
 // WithNodeID returns the copy of context with NodeID which the client balancer will
-// prefer on step of choose YDB endpoint step
+// prefer on step of choose YDB endpoint step.
+// Session pools of query and table clients also prefer idle session on node with NodeID
+// (or create new session on this node if pool is not full) for Do and DoTx calls with this context
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func WithNodeID(ctx context.Context, nodeID uint32) context.Context {
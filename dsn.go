@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
@@ -80,6 +81,13 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 			opts = append(opts, withConnectorOptions(xsql.WithFakeTx(mode)))
 		}
 	}
+	if queryService := info.Params.Get("go_query_service"); queryService != "" {
+		enabled, err := strconv.ParseBool(queryService)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong go_query_service value '%s': %w", queryService, err))
+		}
+		opts = append(opts, withConnectorOptions(xsql.WithQueryService(enabled)))
+	}
 	if info.Params.Has("go_query_bind") {
 		var binders []xsql.ConnectorOption
 		queryTransformers := strings.Split(info.Params.Get("go_query_bind"), ",")
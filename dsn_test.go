@@ -48,6 +48,19 @@ func TestParse(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_service=true&go_query_bind=declare",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.ConnectorOption{
+				xsql.WithQueryService(true),
+				xsql.WithQueryBind(bind.AutoDeclare{}),
+			},
+			err: nil,
+		},
 		{
 			dsn: "grpc://localhost:2135/local",
 			opts: []config.Option{
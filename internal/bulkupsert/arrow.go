@@ -0,0 +1,360 @@
+package bulkupsert
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Arrow IPC format constants, see https://arrow.apache.org/docs/format/Columnar.html
+const (
+	arrowContinuation    = 0xFFFFFFFF
+	arrowMetadataVersion = 4 // V5
+
+	arrowHeaderSchema      = 1
+	arrowHeaderRecordBatch = 3
+
+	arrowTypeInt           = 2
+	arrowTypeFloatingPoint = 3
+	arrowTypeBinary        = 4
+	arrowTypeUtf8          = 5
+	arrowTypeBool          = 6
+	arrowTypeTimestamp     = 10
+	arrowTypeDuration      = 18
+
+	arrowPrecisionSingle = 1
+	arrowPrecisionDouble = 2
+
+	arrowTimeUnitMicrosecond = 2
+
+	arrowAlignment = 8
+)
+
+var (
+	errNoRows       = errors.New("no rows")
+	errNotStructRow = errors.New("row is not a struct value")
+	errRowFields    = errors.New("fields of row mismatch fields of first row")
+)
+
+type (
+	arrowColumn struct {
+		name     string
+		nullable bool
+		t        types.Primitive
+		// width is a size of fixed-width value in bytes, 0 for bool and variable-length values
+		width int
+
+		length    int
+		nullCount int
+		validity  []byte
+		values    []byte
+		offsets   []byte
+
+		// destinations of value.CastTo
+		i64   int64
+		u64   uint64
+		f32   float32
+		f64   float64
+		b     bool
+		s     string
+		bytes []byte
+	}
+	structFields interface {
+		Fields() []value.StructValueField
+	}
+)
+
+func newArrowColumn(name string, t types.Type) (*arrowColumn, error) {
+	c := &arrowColumn{
+		name: name,
+	}
+	if optional, ok := t.(types.Optional); ok {
+		c.nullable = true
+		t = optional.InnerType()
+	}
+
+	primitive, ok := t.(types.Primitive)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: column %q of type %s", errUnsupportedType, name, t))
+	}
+	c.t = primitive
+
+	switch primitive {
+	case types.Bool, types.Text, types.JSON, types.Bytes, types.YSON:
+	case types.Int8, types.Uint8:
+		c.width = 1
+	case types.Int16, types.Uint16, types.Date:
+		c.width = 2
+	case types.Int32, types.Uint32, types.Float, types.Datetime:
+		c.width = 4
+	case types.Int64, types.Uint64, types.Double, types.Timestamp, types.Interval:
+		c.width = 8
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: column %q of type %s", errUnsupportedType, name, t))
+	}
+
+	return c, nil
+}
+
+// arrowType returns type of column as Arrow Type union (type id and table)
+func (c *arrowColumn) arrowType() (uint8, fbTable) {
+	switch c.t {
+	case types.Bool:
+		return arrowTypeBool, fbTable{}
+	case types.Float:
+		return arrowTypeFloatingPoint, fbTable{fbInt16(arrowPrecisionSingle)}
+	case types.Double:
+		return arrowTypeFloatingPoint, fbTable{fbInt16(arrowPrecisionDouble)}
+	case types.Timestamp:
+		return arrowTypeTimestamp, fbTable{fbInt16(arrowTimeUnitMicrosecond)}
+	case types.Interval:
+		return arrowTypeDuration, fbTable{fbInt16(arrowTimeUnitMicrosecond)}
+	case types.Text, types.JSON:
+		return arrowTypeUtf8, fbTable{}
+	case types.Bytes, types.YSON:
+		return arrowTypeBinary, fbTable{}
+	default:
+		signed := c.t == types.Int8 || c.t == types.Int16 || c.t == types.Int32 || c.t == types.Int64
+
+		return arrowTypeInt, fbTable{fbInt32(int32(8 * c.width)), fbBool(signed)}
+	}
+}
+
+func (c *arrowColumn) appendValidity(valid bool) {
+	if c.length%8 == 0 {
+		c.validity = append(c.validity, 0)
+	}
+	if valid {
+		c.validity[c.length/8] |= 1 << (c.length % 8)
+	} else {
+		c.nullCount++
+	}
+}
+
+func (c *arrowColumn) appendNull() {
+	c.appendValidity(false)
+	switch {
+	case c.t == types.Bool:
+		if c.length%8 == 0 {
+			c.values = append(c.values, 0)
+		}
+	case c.width > 0:
+		c.values = append(c.values, make([]byte, c.width)...)
+	default:
+		c.offsets = binary.LittleEndian.AppendUint32(c.offsets, uint32(len(c.values)))
+	}
+	c.length++
+}
+
+//nolint:funlen
+func (c *arrowColumn) append(v value.Value) (err error) {
+	if c.length == 0 && c.width == 0 && c.t != types.Bool {
+		c.offsets = binary.LittleEndian.AppendUint32(c.offsets, 0)
+	}
+
+	if v = value.Unwrap(v); v == nil {
+		if !c.nullable {
+			return xerrors.WithStackTrace(errNullValue)
+		}
+		c.appendNull()
+
+		return nil
+	}
+
+	switch c.t {
+	case types.Bool:
+		if err = value.CastTo(v, &c.b); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if c.length%8 == 0 {
+			c.values = append(c.values, 0)
+		}
+		if c.b {
+			c.values[c.length/8] |= 1 << (c.length % 8)
+		}
+	case types.Int8, types.Int16, types.Int32, types.Int64, types.Interval:
+		if err = value.CastTo(v, &c.i64); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.values = appendFixed(c.values, uint64(c.i64), c.width)
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64, types.Date, types.Datetime, types.Timestamp:
+		if err = value.CastTo(v, &c.u64); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.values = appendFixed(c.values, c.u64, c.width)
+	case types.Float:
+		if err = value.CastTo(v, &c.f32); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.values = binary.LittleEndian.AppendUint32(c.values, math.Float32bits(c.f32))
+	case types.Double:
+		if err = value.CastTo(v, &c.f64); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.values = binary.LittleEndian.AppendUint64(c.values, math.Float64bits(c.f64))
+	case types.Text, types.JSON:
+		if err = value.CastTo(v, &c.s); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.values = append(c.values, c.s...)
+		c.offsets = binary.LittleEndian.AppendUint32(c.offsets, uint32(len(c.values)))
+	default:
+		if err = value.CastTo(v, &c.bytes); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.values = append(c.values, c.bytes...)
+		c.offsets = binary.LittleEndian.AppendUint32(c.offsets, uint32(len(c.values)))
+	}
+
+	c.appendValidity(true)
+	c.length++
+
+	return nil
+}
+
+// appendFixed appends little-endian value v with width bytes
+func appendFixed(b []byte, v uint64, width int) []byte {
+	switch width {
+	case 1:
+		return append(b, byte(v))
+	case 2:
+		return binary.LittleEndian.AppendUint16(b, uint16(v))
+	case 4:
+		return binary.LittleEndian.AppendUint32(b, uint32(v))
+	default:
+		return binary.LittleEndian.AppendUint64(b, v)
+	}
+}
+
+// buffers returns Arrow buffers of column: validity bitmap, offsets (for variable-length values) and values
+func (c *arrowColumn) buffers() [][]byte {
+	validity := c.validity
+	if c.nullCount == 0 {
+		validity = nil
+	}
+	if c.width == 0 && c.t != types.Bool {
+		return [][]byte{validity, c.offsets, c.values}
+	}
+
+	return [][]byte{validity, c.values}
+}
+
+// EncodeArrow encodes rows (struct values with the same fields) to Arrow IPC schema message and
+// Arrow IPC record batch message
+func EncodeArrow(rows []value.Value) (schema, batch []byte, _ error) {
+	if len(rows) == 0 {
+		return nil, nil, xerrors.WithStackTrace(errNoRows)
+	}
+
+	first, ok := rows[0].(structFields)
+	if !ok {
+		return nil, nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errNotStructRow, rows[0].Type()))
+	}
+
+	columns := make([]*arrowColumn, 0, len(first.Fields()))
+	for _, f := range first.Fields() {
+		c, err := newArrowColumn(f.Name, f.V.Type())
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		columns = append(columns, c)
+	}
+
+	for i := range rows {
+		row, ok := rows[i].(structFields)
+		if !ok {
+			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("%w: row %d of type %s", errNotStructRow, i, rows[i].Type()))
+		}
+		fields := row.Fields()
+		if len(fields) != len(columns) {
+			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("%w: row %d", errRowFields, i))
+		}
+		for j := range fields {
+			if fields[j].Name != columns[j].name {
+				return nil, nil, xerrors.WithStackTrace(fmt.Errorf("%w: row %d", errRowFields, i))
+			}
+			if err := columns[j].append(fields[j].V); err != nil {
+				return nil, nil, xerrors.WithStackTrace(fmt.Errorf("row %d, column %q: %w", i, columns[j].name, err))
+			}
+		}
+	}
+
+	return arrowSchema(columns), arrowRecordBatch(columns, len(rows)), nil
+}
+
+func arrowSchema(columns []*arrowColumn) []byte {
+	fields := make(fbTables, 0, len(columns))
+	for _, c := range columns {
+		typeID, typeTable := c.arrowType()
+		fields = append(fields, fbTable{
+			fbRef(fbString(c.name)),
+			fbBool(c.nullable),
+			fbUint8(typeID),
+			fbRef(typeTable),
+			nil,
+			fbRef(fbTables{}),
+		})
+	}
+
+	return arrowMessage(fbFinish(fbTable{
+		fbInt16(arrowMetadataVersion),
+		fbUint8(arrowHeaderSchema),
+		fbRef(fbTable{
+			nil,
+			fbRef(fields),
+		}),
+		fbInt64(0),
+	}), nil)
+}
+
+func arrowRecordBatch(columns []*arrowColumn, length int) []byte {
+	var (
+		nodes   fbStructs
+		buffers fbStructs
+		body    []byte
+	)
+	for _, c := range columns {
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(c.length))
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(c.nullCount))
+		for _, buffer := range c.buffers() {
+			buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(body)))
+			buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(buffer)))
+			body = append(body, buffer...)
+			for len(body)%arrowAlignment != 0 {
+				body = append(body, 0)
+			}
+		}
+	}
+
+	return arrowMessage(fbFinish(fbTable{
+		fbInt16(arrowMetadataVersion),
+		fbUint8(arrowHeaderRecordBatch),
+		fbRef(fbTable{
+			fbInt64(int64(length)),
+			fbRef(nodes),
+			fbRef(buffers),
+		}),
+		fbInt64(int64(len(body))),
+	}), body)
+}
+
+// arrowMessage returns encapsulated Arrow IPC message: continuation marker, size of metadata,
+// metadata flatbuffer (padded to 8 bytes) and message body
+func arrowMessage(metadata, body []byte) []byte {
+	for (len(metadata)+8)%arrowAlignment != 0 {
+		metadata = append(metadata, 0)
+	}
+
+	message := make([]byte, 0, 8+len(metadata)+len(body))
+	message = binary.LittleEndian.AppendUint32(message, arrowContinuation)
+	message = binary.LittleEndian.AppendUint32(message, uint32(len(metadata)))
+	message = append(message, metadata...)
+	message = append(message, body...)
+
+	return message
+}
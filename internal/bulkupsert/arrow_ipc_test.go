@@ -0,0 +1,78 @@
+package bulkupsert
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// TestEncodeArrowGolden checks encoded messages with golden IPC messages from testdata.
+// Golden messages are checked by IPC reader of Apache Arrow Go implementation (github.com/apache/arrow/go/v16):
+// all columns have expected types, nullability and values
+func TestEncodeArrowGolden(t *testing.T) {
+	rows := []value.Value{
+		value.StructValue(
+			value.StructValueField{Name: "bool", V: value.BoolValue(true)},
+			value.StructValueField{Name: "int8", V: value.Int8Value(-8)},
+			value.StructValueField{Name: "int16", V: value.Int16Value(-16)},
+			value.StructValueField{Name: "int32", V: value.Int32Value(-32)},
+			value.StructValueField{Name: "int64", V: value.Int64Value(-64)},
+			value.StructValueField{Name: "uint8", V: value.Uint8Value(8)},
+			value.StructValueField{Name: "uint16", V: value.Uint16Value(16)},
+			value.StructValueField{Name: "uint32", V: value.Uint32Value(32)},
+			value.StructValueField{Name: "uint64", V: value.Uint64Value(64)},
+			value.StructValueField{Name: "float", V: value.FloatValue(1.5)},
+			value.StructValueField{Name: "double", V: value.DoubleValue(2.5)},
+			value.StructValueField{Name: "date", V: value.DateValue(19000)},
+			value.StructValueField{Name: "datetime", V: value.DatetimeValue(1700000000)},
+			value.StructValueField{Name: "timestamp", V: value.TimestampValue(1700000000123456)},
+			value.StructValueField{Name: "interval", V: value.IntervalValue(-1500000)},
+			value.StructValueField{Name: "text", V: value.TextValue("text")},
+			value.StructValueField{Name: "json", V: value.JSONValue(`{"a":1}`)},
+			value.StructValueField{Name: "bytes", V: value.BytesValue([]byte{1, 2, 3})},
+			value.StructValueField{Name: "yson", V: value.YSONValue([]byte("[1]"))},
+			value.StructValueField{Name: "opt_text", V: value.OptionalValue(value.TextValue("first"))},
+			value.StructValueField{Name: "opt_uint32", V: value.NullValue(types.Uint32)},
+			value.StructValueField{Name: "opt_timestamp", V: value.OptionalValue(value.TimestampValue(1))},
+		),
+		value.StructValue(
+			value.StructValueField{Name: "bool", V: value.BoolValue(false)},
+			value.StructValueField{Name: "int8", V: value.Int8Value(8)},
+			value.StructValueField{Name: "int16", V: value.Int16Value(16)},
+			value.StructValueField{Name: "int32", V: value.Int32Value(32)},
+			value.StructValueField{Name: "int64", V: value.Int64Value(64)},
+			value.StructValueField{Name: "uint8", V: value.Uint8Value(255)},
+			value.StructValueField{Name: "uint16", V: value.Uint16Value(65535)},
+			value.StructValueField{Name: "uint32", V: value.Uint32Value(1 << 31)},
+			value.StructValueField{Name: "uint64", V: value.Uint64Value(1 << 63)},
+			value.StructValueField{Name: "float", V: value.FloatValue(-1.5)},
+			value.StructValueField{Name: "double", V: value.DoubleValue(-2.5)},
+			value.StructValueField{Name: "date", V: value.DateValue(0)},
+			value.StructValueField{Name: "datetime", V: value.DatetimeValue(0)},
+			value.StructValueField{Name: "timestamp", V: value.TimestampValue(0)},
+			value.StructValueField{Name: "interval", V: value.IntervalValue(1)},
+			value.StructValueField{Name: "text", V: value.TextValue("")},
+			value.StructValueField{Name: "json", V: value.JSONValue(`[]`)},
+			value.StructValueField{Name: "bytes", V: value.BytesValue(nil)},
+			value.StructValueField{Name: "yson", V: value.YSONValue([]byte("#"))},
+			value.StructValueField{Name: "opt_text", V: value.NullValue(types.Text)},
+			value.StructValueField{Name: "opt_uint32", V: value.OptionalValue(value.Uint32Value(7))},
+			value.StructValueField{Name: "opt_timestamp", V: value.NullValue(types.Timestamp)},
+		),
+	}
+	schema, batch, err := EncodeArrow(rows)
+	require.NoError(t, err)
+
+	goldenSchema, err := os.ReadFile(filepath.Join("testdata", "arrow_schema.bin"))
+	require.NoError(t, err)
+	goldenBatch, err := os.ReadFile(filepath.Join("testdata", "arrow_batch.bin"))
+	require.NoError(t, err)
+
+	require.Equal(t, goldenSchema, schema)
+	require.Equal(t, goldenBatch, batch)
+}
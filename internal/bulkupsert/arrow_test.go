@@ -0,0 +1,210 @@
+package bulkupsert
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// fbTestTable reads flatbuffers table for checks of encoded messages
+type fbTestTable struct {
+	t   *testing.T
+	buf []byte
+	pos int
+}
+
+func fbTestRoot(t *testing.T, buf []byte) fbTestTable {
+	return fbTestTable{t: t, buf: buf, pos: int(binary.LittleEndian.Uint32(buf))}
+}
+
+func (r fbTestTable) field(i int) int {
+	require.Zero(r.t, r.pos%4, "table is not aligned")
+	vtable := r.pos - int(int32(binary.LittleEndian.Uint32(r.buf[r.pos:])))
+	require.Zero(r.t, vtable%2, "vtable is not aligned")
+	if 4+2*i >= int(binary.LittleEndian.Uint16(r.buf[vtable:])) {
+		return 0
+	}
+	offset := int(binary.LittleEndian.Uint16(r.buf[vtable+4+2*i:]))
+	if offset == 0 {
+		return 0
+	}
+
+	return r.pos + offset
+}
+
+func (r fbTestTable) uint8(i int) uint8 {
+	return r.buf[r.field(i)]
+}
+
+func (r fbTestTable) int16(i int) int16 {
+	pos := r.field(i)
+	require.Zero(r.t, pos%2)
+
+	return int16(binary.LittleEndian.Uint16(r.buf[pos:]))
+}
+
+func (r fbTestTable) int32(i int) int32 {
+	pos := r.field(i)
+	require.Zero(r.t, pos%4)
+
+	return int32(binary.LittleEndian.Uint32(r.buf[pos:]))
+}
+
+func (r fbTestTable) int64(i int) int64 {
+	pos := r.field(i)
+	require.Zero(r.t, pos%8)
+
+	return int64(binary.LittleEndian.Uint64(r.buf[pos:]))
+}
+
+func (r fbTestTable) ref(i int) int {
+	pos := r.field(i)
+	require.NotZero(r.t, pos)
+
+	return pos + int(binary.LittleEndian.Uint32(r.buf[pos:]))
+}
+
+func (r fbTestTable) table(i int) fbTestTable {
+	return fbTestTable{t: r.t, buf: r.buf, pos: r.ref(i)}
+}
+
+func (r fbTestTable) string(i int) string {
+	pos := r.ref(i)
+	n := int(binary.LittleEndian.Uint32(r.buf[pos:]))
+	require.Zero(r.t, r.buf[pos+4+n], "string is not null-terminated")
+
+	return string(r.buf[pos+4 : pos+4+n])
+}
+
+func (r fbTestTable) tables(i int) (tables []fbTestTable) {
+	pos := r.ref(i)
+	for j := 0; j < int(binary.LittleEndian.Uint32(r.buf[pos:])); j++ {
+		item := pos + 4 + 4*j
+		tables = append(tables, fbTestTable{t: r.t, buf: r.buf, pos: item + int(binary.LittleEndian.Uint32(r.buf[item:]))})
+	}
+
+	return tables
+}
+
+func (r fbTestTable) structs(i int) (items [][2]int64) {
+	pos := r.ref(i)
+	require.Zero(r.t, (pos+4)%8, "structs are not aligned")
+	for j := 0; j < int(binary.LittleEndian.Uint32(r.buf[pos:])); j++ {
+		item := pos + 4 + 16*j
+		items = append(items, [2]int64{
+			int64(binary.LittleEndian.Uint64(r.buf[item:])),
+			int64(binary.LittleEndian.Uint64(r.buf[item+8:])),
+		})
+	}
+
+	return items
+}
+
+func testArrowMessage(t *testing.T, message []byte) (fbTestTable, []byte) {
+	require.Equal(t, uint32(arrowContinuation), binary.LittleEndian.Uint32(message))
+	size := int(binary.LittleEndian.Uint32(message[4:]))
+	require.Zero(t, (8+size)%8)
+	root := fbTestRoot(t, message[8:8+size])
+	require.Equal(t, int16(arrowMetadataVersion), root.int16(0))
+	body := message[8+size:]
+	require.Equal(t, int64(len(body)), root.int64(3))
+
+	return root, body
+}
+
+func TestEncodeArrow(t *testing.T) {
+	rows := []value.Value{
+		value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+			value.StructValueField{Name: "title", V: value.OptionalValue(value.TextValue("first"))},
+			value.StructValueField{Name: "flag", V: value.BoolValue(true)},
+			value.StructValueField{Name: "delta", V: value.Int16Value(-2)},
+		),
+		value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+			value.StructValueField{Name: "title", V: value.NullValue(types.Text)},
+			value.StructValueField{Name: "flag", V: value.BoolValue(false)},
+			value.StructValueField{Name: "delta", V: value.Int16Value(3)},
+		),
+		value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(3)},
+			value.StructValueField{Name: "title", V: value.OptionalValue(value.TextValue("third"))},
+			value.StructValueField{Name: "flag", V: value.BoolValue(true)},
+			value.StructValueField{Name: "delta", V: value.Int16Value(4)},
+		),
+	}
+	schema, batch, err := EncodeArrow(rows)
+	require.NoError(t, err)
+
+	t.Run("Schema", func(t *testing.T) {
+		root, body := testArrowMessage(t, schema)
+		require.Empty(t, body)
+		require.Equal(t, uint8(arrowHeaderSchema), root.uint8(1))
+		fields := root.table(2).tables(1)
+		require.Len(t, fields, 4)
+		for i, exp := range []struct {
+			name     string
+			nullable uint8
+			typeID   uint8
+		}{
+			{name: "delta", typeID: arrowTypeInt},
+			{name: "flag", typeID: arrowTypeBool},
+			{name: "id", typeID: arrowTypeInt},
+			{name: "title", nullable: 1, typeID: arrowTypeUtf8},
+		} {
+			require.Equal(t, exp.name, fields[i].string(0))
+			require.Equal(t, exp.nullable, fields[i].uint8(1))
+			require.Equal(t, exp.typeID, fields[i].uint8(2))
+			require.Empty(t, fields[i].tables(5))
+		}
+		require.Equal(t, int32(16), fields[0].table(3).int32(0))
+		require.Equal(t, uint8(1), fields[0].table(3).uint8(1))
+		require.Equal(t, int32(64), fields[2].table(3).int32(0))
+		require.Equal(t, uint8(0), fields[2].table(3).uint8(1))
+	})
+	t.Run("RecordBatch", func(t *testing.T) {
+		root, body := testArrowMessage(t, batch)
+		require.Equal(t, uint8(arrowHeaderRecordBatch), root.uint8(1))
+		recordBatch := root.table(2)
+		require.Equal(t, int64(3), recordBatch.int64(0))
+		require.Equal(t, [][2]int64{{3, 0}, {3, 0}, {3, 0}, {3, 1}}, recordBatch.structs(1))
+		buffers := recordBatch.structs(2)
+		require.Len(t, buffers, 2+2+2+3)
+		buffer := func(i int) []byte {
+			require.Zero(t, buffers[i][0]%8)
+
+			return body[buffers[i][0] : buffers[i][0]+buffers[i][1]]
+		}
+		require.Empty(t, buffer(0))
+		require.Equal(t, []byte{0xfe, 0xff, 3, 0, 4, 0}, buffer(1))
+		require.Equal(t, []byte{0b101}, buffer(3))
+		require.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0}, buffer(5))
+		require.Equal(t, []byte{0b101}, buffer(6))
+		require.Equal(t, []byte{0, 0, 0, 0, 5, 0, 0, 0, 5, 0, 0, 0, 10, 0, 0, 0}, buffer(7))
+		require.Equal(t, "firstthird", string(buffer(8)))
+	})
+	t.Run("Errors", func(t *testing.T) {
+		_, _, err := EncodeArrow(nil)
+		require.ErrorIs(t, err, errNoRows)
+		_, _, err = EncodeArrow([]value.Value{value.Uint64Value(1)})
+		require.ErrorIs(t, err, errNotStructRow)
+		_, _, err = EncodeArrow([]value.Value{
+			value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(1)}),
+			value.StructValue(value.StructValueField{Name: "name", V: value.Uint64Value(1)}),
+		})
+		require.ErrorIs(t, err, errRowFields)
+		_, _, err = EncodeArrow([]value.Value{
+			value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(1)}),
+			value.StructValue(value.StructValueField{Name: "id", V: value.NullValue(types.Uint64)}),
+		})
+		require.ErrorIs(t, err, errNullValue)
+		_, _, err = EncodeArrow([]value.Value{
+			value.StructValue(value.StructValueField{Name: "id", V: value.UUIDValue([16]byte{})}),
+		})
+		require.ErrorIs(t, err, errUnsupportedType)
+	})
+}
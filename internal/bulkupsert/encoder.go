@@ -0,0 +1,415 @@
+package bulkupsert
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const tagName = "sql"
+
+var (
+	errNotStruct       = errors.New("type is not a struct")
+	errUnknownColumn   = errors.New("column of struct field not found")
+	errUnsupportedType = errors.New("unsupported type")
+	errNullValue       = errors.New("null value for not optional column")
+	errOverflow        = errors.New("value overflows column type")
+
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	uuidType     = reflect.TypeOf([16]byte{})
+	valuerType   = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	valueType    = reflect.TypeOf((*value.Value)(nil)).Elem()
+)
+
+type (
+	Column struct {
+		Name string
+		Type types.Type
+	}
+	field struct {
+		index  int
+		column Column
+	}
+	// Encoder converts structs to ydb struct values with types of columns
+	Encoder struct {
+		fields []field
+	}
+)
+
+// NewEncoder makes encoder of structs with type t. Exported fields of struct mapped to columns by tag "sql"
+// (field name by default), fields with tag "-" are skipped.
+// If columns is empty types of columns are inferred from types of struct fields
+func NewEncoder(t reflect.Type, columns []Column) (*Encoder, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errNotStruct, t))
+	}
+
+	columnTypes := make(map[string]types.Type, len(columns))
+	for i := range columns {
+		columnTypes[columns[i].Name] = columns[i].Type
+	}
+
+	e := &Encoder{
+		fields: make([]field, 0, t.NumField()),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag, has := f.Tag.Lookup(tagName); has {
+			name = tag
+		}
+		if name == "-" {
+			continue
+		}
+
+		column := Column{
+			Name: name,
+		}
+		if len(columns) > 0 {
+			t, has := columnTypes[name]
+			if !has {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q (field %s)", errUnknownColumn, name, f.Name))
+			}
+			column.Type = t
+		} else {
+			t, err := typeOf(f.Type)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("field %s: %w", f.Name, err))
+			}
+			column.Type = t
+		}
+
+		e.fields = append(e.fields, field{
+			index:  i,
+			column: column,
+		})
+	}
+
+	return e, nil
+}
+
+// Columns returns columns of encoded structs
+func (e *Encoder) Columns() []Column {
+	columns := make([]Column, 0, len(e.fields))
+	for i := range e.fields {
+		columns = append(columns, e.fields[i].column)
+	}
+
+	return columns
+}
+
+// Encode converts struct v to ydb struct value. Encode also returns approximate size of value in bytes
+func (e *Encoder) Encode(v reflect.Value) (_ value.Value, size int, _ error) {
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	fields := make([]value.StructValueField, 0, len(e.fields))
+	for i := range e.fields {
+		f, n, err := castTo(v.Field(e.fields[i].index), e.fields[i].column.Type)
+		if err != nil {
+			return nil, 0, xerrors.WithStackTrace(fmt.Errorf("column %q: %w", e.fields[i].column.Name, err))
+		}
+		fields = append(fields, value.StructValueField{
+			Name: e.fields[i].column.Name,
+			V:    f,
+		})
+		size += len(e.fields[i].column.Name) + n
+	}
+
+	return value.StructValue(fields...), size, nil
+}
+
+// EncodeValue converts go value v to ydb value of type t
+func EncodeValue(v reflect.Value, t types.Type) (value.Value, error) {
+	x, _, err := castTo(v, t)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return x, nil
+}
+
+// typeOf returns ydb type of go type t
+func typeOf(t reflect.Type) (types.Type, error) {
+	switch {
+	case t.Kind() == reflect.Ptr:
+		inner, err := typeOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewOptional(inner), nil
+	case t == timeType:
+		return types.Timestamp, nil
+	case t == durationType:
+		return types.Interval, nil
+	case t == uuidType:
+		return types.UUID, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.Bool, nil
+	case reflect.Int8:
+		return types.Int8, nil
+	case reflect.Int16:
+		return types.Int16, nil
+	case reflect.Int, reflect.Int32:
+		return types.Int32, nil
+	case reflect.Int64:
+		return types.Int64, nil
+	case reflect.Uint8:
+		return types.Uint8, nil
+	case reflect.Uint16:
+		return types.Uint16, nil
+	case reflect.Uint, reflect.Uint32:
+		return types.Uint32, nil
+	case reflect.Uint64:
+		return types.Uint64, nil
+	case reflect.Float32:
+		return types.Float, nil
+	case reflect.Float64:
+		return types.Double, nil
+	case reflect.String:
+		return types.Text, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return types.Bytes, nil
+		}
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedType, t))
+}
+
+// castTo converts go value v to ydb value of type t. castTo also returns approximate size of value in bytes
+//
+//nolint:gocyclo,funlen
+func castTo(v reflect.Value, t types.Type) (_ value.Value, size int, _ error) {
+	if v.Type().Implements(valueType) {
+		if (v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr) && v.IsNil() {
+			return nil, 0, xerrors.WithStackTrace(errNullValue)
+		}
+
+		return v.Interface().(value.Value), 0, nil //nolint:forcetypeassert
+	}
+
+	if optional, ok := t.(types.Optional); ok {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return value.NullValue(optional.InnerType()), 1, nil
+			}
+			v = v.Elem()
+		}
+		if v.Type().Implements(valuerType) {
+			x, err := v.Interface().(driver.Valuer).Value() //nolint:forcetypeassert
+			if err != nil {
+				return nil, 0, xerrors.WithStackTrace(err)
+			}
+			if x == nil {
+				return value.NullValue(optional.InnerType()), 1, nil
+			}
+			v = reflect.ValueOf(x)
+		}
+		inner, size, err := castTo(v, optional.InnerType())
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return value.OptionalValue(inner), size, nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, 0, xerrors.WithStackTrace(errNullValue)
+		}
+		v = v.Elem()
+	}
+
+	primitive, ok := t.(types.Primitive)
+	if !ok {
+		return nil, 0, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedType, t))
+	}
+
+	const fixedSize = 8
+
+	switch primitive {
+	case types.Bool:
+		if v.Kind() == reflect.Bool {
+			return value.BoolValue(v.Bool()), 1, nil
+		}
+	case types.Int8, types.Int16, types.Int32, types.Int64:
+		x, err := toInt64(v, primitive)
+		if err != nil {
+			return nil, 0, err
+		}
+		switch primitive {
+		case types.Int8:
+			return value.Int8Value(int8(x)), fixedSize, nil
+		case types.Int16:
+			return value.Int16Value(int16(x)), fixedSize, nil
+		case types.Int32:
+			return value.Int32Value(int32(x)), fixedSize, nil
+		default:
+			return value.Int64Value(x), fixedSize, nil
+		}
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		x, err := toUint64(v, primitive)
+		if err != nil {
+			return nil, 0, err
+		}
+		switch primitive {
+		case types.Uint8:
+			return value.Uint8Value(uint8(x)), fixedSize, nil
+		case types.Uint16:
+			return value.Uint16Value(uint16(x)), fixedSize, nil
+		case types.Uint32:
+			return value.Uint32Value(uint32(x)), fixedSize, nil
+		default:
+			return value.Uint64Value(x), fixedSize, nil
+		}
+	case types.Float:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return value.FloatValue(float32(v.Float())), fixedSize, nil
+		}
+	case types.Double:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return value.DoubleValue(v.Float()), fixedSize, nil
+		}
+	case types.Date, types.Datetime, types.Timestamp:
+		if v.Type() == timeType {
+			tm := v.Interface().(time.Time) //nolint:forcetypeassert
+			switch primitive {
+			case types.Date:
+				return value.DateValueFromTime(tm), fixedSize, nil
+			case types.Datetime:
+				return value.DatetimeValueFromTime(tm), fixedSize, nil
+			default:
+				return value.TimestampValueFromTime(tm), fixedSize, nil
+			}
+		}
+	case types.Interval:
+		if v.Type() == durationType {
+			return value.IntervalValueFromDuration(time.Duration(v.Int())), fixedSize, nil
+		}
+	case types.UUID:
+		if v.Type().ConvertibleTo(uuidType) {
+			return value.UUIDValue(v.Convert(uuidType).Interface().([16]byte)), 16, nil //nolint:forcetypeassert
+		}
+	case types.Bytes, types.YSON:
+		var b []byte
+		switch {
+		case v.Kind() == reflect.String:
+			b = []byte(v.String())
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			b = v.Bytes()
+		default:
+			return nil, 0, xerrors.WithStackTrace(fmt.Errorf("%w: %s to %s", errUnsupportedType, v.Type(), t))
+		}
+		if primitive == types.YSON {
+			return value.YSONValue(b), len(b), nil
+		}
+
+		return value.BytesValue(b), len(b), nil
+	case types.Text, types.JSON, types.JSONDocument, types.DyNumber:
+		var s string
+		switch {
+		case v.Kind() == reflect.String:
+			s = v.String()
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			s = string(v.Bytes())
+		default:
+			return nil, 0, xerrors.WithStackTrace(fmt.Errorf("%w: %s to %s", errUnsupportedType, v.Type(), t))
+		}
+		switch primitive {
+		case types.JSON:
+			return value.JSONValue(s), len(s), nil
+		case types.JSONDocument:
+			return value.JSONDocumentValue(s), len(s), nil
+		case types.DyNumber:
+			return value.DyNumberValue(s), len(s), nil
+		default:
+			return value.TextValue(s), len(s), nil
+		}
+	}
+
+	return nil, 0, xerrors.WithStackTrace(fmt.Errorf("%w: %s to %s", errUnsupportedType, v.Type(), t))
+}
+
+func toInt64(v reflect.Value, t types.Primitive) (int64, error) {
+	var x int64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %d to %s", errOverflow, v.Uint(), t))
+		}
+		x = int64(v.Uint())
+	default:
+		return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %s to %s", errUnsupportedType, v.Type(), t))
+	}
+
+	var min, max int64
+	switch t {
+	case types.Int8:
+		min, max = math.MinInt8, math.MaxInt8
+	case types.Int16:
+		min, max = math.MinInt16, math.MaxInt16
+	case types.Int32:
+		min, max = math.MinInt32, math.MaxInt32
+	default:
+		min, max = math.MinInt64, math.MaxInt64
+	}
+	if x < min || x > max {
+		return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %d to %s", errOverflow, x, t))
+	}
+
+	return x, nil
+}
+
+func toUint64(v reflect.Value, t types.Primitive) (uint64, error) {
+	var x uint64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %d to %s", errOverflow, v.Int(), t))
+		}
+		x = uint64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		x = v.Uint()
+	default:
+		return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %s to %s", errUnsupportedType, v.Type(), t))
+	}
+
+	var max uint64
+	switch t {
+	case types.Uint8:
+		max = math.MaxUint8
+	case types.Uint16:
+		max = math.MaxUint16
+	case types.Uint32:
+		max = math.MaxUint32
+	default:
+		max = math.MaxUint64
+	}
+	if x > max {
+		return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %d to %s", errOverflow, x, t))
+	}
+
+	return x, nil
+}
@@ -0,0 +1,98 @@
+package bulkupsert
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestCastTo(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+		t    types.Type
+		exp  value.Value
+		err  error
+	}{
+		{name: "Bool", src: true, t: types.Bool, exp: value.BoolValue(true)},
+		{name: "IntToInt8", src: 5, t: types.Int8, exp: value.Int8Value(5)},
+		{name: "IntToInt8Overflow", src: 500, t: types.Int8, err: errOverflow},
+		{name: "UintToInt64", src: uint32(5), t: types.Int64, exp: value.Int64Value(5)},
+		{name: "IntToUint64", src: 5, t: types.Uint64, exp: value.Uint64Value(5)},
+		{name: "NegativeToUint64", src: -5, t: types.Uint64, err: errOverflow},
+		{name: "Uint64ToUint16Overflow", src: uint64(1 << 20), t: types.Uint16, err: errOverflow},
+		{name: "Double", src: 1.5, t: types.Double, exp: value.DoubleValue(1.5)},
+		{name: "Float", src: float32(1.5), t: types.Float, exp: value.FloatValue(1.5)},
+		{name: "StringToText", src: "test", t: types.Text, exp: value.TextValue("test")},
+		{name: "StringToBytes", src: "test", t: types.Bytes, exp: value.BytesValue([]byte("test"))},
+		{name: "BytesToJSON", src: []byte("{}"), t: types.JSON, exp: value.JSONValue("{}")},
+		{name: "Date", src: ts, t: types.Date, exp: value.DateValueFromTime(ts)},
+		{name: "Timestamp", src: ts, t: types.Timestamp, exp: value.TimestampValueFromTime(ts)},
+		{name: "Interval", src: time.Second, t: types.Interval, exp: value.IntervalValueFromDuration(time.Second)},
+		{name: "UUID", src: [16]byte{1}, t: types.UUID, exp: value.UUIDValue([16]byte{1})},
+		{
+			name: "Optional",
+			src:  func() *int { v := 5; return &v }(),
+			t:    types.NewOptional(types.Int32),
+			exp:  value.OptionalValue(value.Int32Value(5)),
+		},
+		{name: "Null", src: (*int)(nil), t: types.NewOptional(types.Int32), exp: value.NullValue(types.Int32)},
+		{name: "NullForNotOptional", src: (*int)(nil), t: types.Int32, err: errNullValue},
+		{name: "NullString", src: sql.NullString{}, t: types.NewOptional(types.Text), exp: value.NullValue(types.Text)},
+		{
+			name: "ValidNullString",
+			src:  sql.NullString{String: "test", Valid: true},
+			t:    types.NewOptional(types.Text),
+			exp:  value.OptionalValue(value.TextValue("test")),
+		},
+		{name: "Value", src: value.Uint64Value(5), t: types.Uint64, exp: value.Uint64Value(5)},
+		{name: "Unsupported", src: struct{}{}, t: types.Text, err: errUnsupportedType},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _, err := castTo(reflect.ValueOf(tt.src), tt.t)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.exp.Yql(), v.Yql())
+			require.True(t, types.Equal(tt.exp.Type(), v.Type()))
+		})
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	type row struct {
+		ID      uint64 `sql:"id"`
+		Name    string
+		Ignored int `sql:"-"`
+	}
+	t.Run("InferredTypes", func(t *testing.T) {
+		e, err := NewEncoder(reflect.TypeOf(row{}), nil)
+		require.NoError(t, err)
+		require.Equal(t, []Column{
+			{Name: "id", Type: types.Uint64},
+			{Name: "Name", Type: types.Text},
+		}, e.Columns())
+		v, size, err := e.Encode(reflect.ValueOf(&row{ID: 1, Name: "test"}))
+		require.NoError(t, err)
+		require.Equal(t, "<|`Name`:\"test\"u,`id`:1ul|>", v.Yql())
+		require.Positive(t, size)
+	})
+	t.Run("UnknownColumn", func(t *testing.T) {
+		_, err := NewEncoder(reflect.TypeOf(row{}), []Column{{Name: "id", Type: types.Uint64}})
+		require.ErrorIs(t, err, errUnknownColumn)
+	})
+	t.Run("NotStruct", func(t *testing.T) {
+		_, err := NewEncoder(reflect.TypeOf(1), nil)
+		require.ErrorIs(t, err, errNotStruct)
+	})
+}
@@ -0,0 +1,156 @@
+package bulkupsert
+
+import (
+	"encoding/binary"
+)
+
+// Minimal flatbuffers writer for Arrow IPC metadata. Objects are written front-to-back:
+// each table is followed by objects referenced from it, so all unsigned offsets point forward
+
+type (
+	fbObject interface {
+		// write writes object to builder and returns position of object
+		write(b *fbBuilder) int
+	}
+	fbField struct {
+		scalar []byte
+		ref    fbObject
+	}
+	fbTable   []*fbField
+	fbTables  []fbTable
+	fbString  string
+	fbStructs []byte
+	fbBuilder struct{ buf []byte }
+)
+
+func fbUint8(v uint8) *fbField {
+	return &fbField{scalar: []byte{v}}
+}
+
+func fbBool(v bool) *fbField {
+	if v {
+		return fbUint8(1)
+	}
+
+	return fbUint8(0)
+}
+
+func fbInt16(v int16) *fbField {
+	return &fbField{scalar: binary.LittleEndian.AppendUint16(nil, uint16(v))}
+}
+
+func fbInt32(v int32) *fbField {
+	return &fbField{scalar: binary.LittleEndian.AppendUint32(nil, uint32(v))}
+}
+
+func fbInt64(v int64) *fbField {
+	return &fbField{scalar: binary.LittleEndian.AppendUint64(nil, uint64(v))}
+}
+
+func fbRef(obj fbObject) *fbField {
+	return &fbField{ref: obj}
+}
+
+func (f *fbField) size() int {
+	if f.ref != nil {
+		return 4
+	}
+
+	return len(f.scalar)
+}
+
+func (b *fbBuilder) pad(align, extra int) {
+	for (len(b.buf)+extra)%align != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+func (b *fbBuilder) patchOffset(at, to int) {
+	binary.LittleEndian.PutUint32(b.buf[at:], uint32(to-at))
+}
+
+func (t fbTable) write(b *fbBuilder) int {
+	// layout of table: soffset to vtable and fields aligned by their sizes
+	offsets := make([]int, len(t))
+	size := 4
+	for i, f := range t {
+		if f == nil {
+			continue
+		}
+		for size%f.size() != 0 {
+			size++
+		}
+		offsets[i] = size
+		size += f.size()
+	}
+
+	b.pad(2, 0)
+	vtable := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(4+2*len(t)))
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(size))
+	for i := range offsets {
+		b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(offsets[i]))
+	}
+
+	b.pad(8, 0)
+	table := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(table-vtable))
+	b.buf = append(b.buf, make([]byte, size-4)...)
+	for i, f := range t {
+		if f != nil && f.ref == nil {
+			copy(b.buf[table+offsets[i]:], f.scalar)
+		}
+	}
+
+	for i, f := range t {
+		if f != nil && f.ref != nil {
+			b.patchOffset(table+offsets[i], f.ref.write(b))
+		}
+	}
+
+	return table
+}
+
+func (tables fbTables) write(b *fbBuilder) int {
+	b.pad(4, 0)
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(tables)))
+	b.buf = append(b.buf, make([]byte, 4*len(tables))...)
+	for i, t := range tables {
+		b.patchOffset(pos+4+4*i, t.write(b))
+	}
+
+	return pos
+}
+
+func (s fbString) write(b *fbBuilder) int {
+	b.pad(4, 0)
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(s)))
+	b.buf = append(b.buf, s...)
+	b.buf = append(b.buf, 0)
+
+	return pos
+}
+
+// write writes vector of 16-bytes structs (two longs), so items must be aligned to 8
+func (s fbStructs) write(b *fbBuilder) int {
+	const structSize = 16
+
+	b.pad(8, 4)
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(s)/structSize))
+	b.buf = append(b.buf, s...)
+
+	return pos
+}
+
+// fbFinish returns flatbuffer with root table
+func fbFinish(root fbTable) []byte {
+	b := &fbBuilder{
+		buf: make([]byte, 4, 256),
+	}
+	b.patchOffset(0, root.write(b))
+
+	return b.buf
+}
@@ -10,3 +10,14 @@ func Int64Slice(src []int64) []int64 {
 
 	return dst
 }
+
+func ByteSlice(src []byte) []byte {
+	if src == nil {
+		return nil
+	}
+
+	dst := make([]byte, len(src))
+	copy(dst, src)
+
+	return dst
+}
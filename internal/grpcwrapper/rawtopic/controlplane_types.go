@@ -2,8 +2,10 @@ package rawtopic
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
@@ -46,9 +48,76 @@ const (
 	MeteringModeRequestUnits     = MeteringMode(Ydb_Topic.MeteringMode_METERING_MODE_REQUEST_UNITS)
 )
 
+type AutoPartitioningStrategy int32
+
+const (
+	AutoPartitioningStrategyUnspecified = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_UNSPECIFIED,
+	)
+	AutoPartitioningStrategyDisabled = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_DISABLED,
+	)
+	AutoPartitioningStrategyScaleUp = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_SCALE_UP,
+	)
+	AutoPartitioningStrategyScaleUpAndDown = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_SCALE_UP_AND_DOWN,
+	)
+	AutoPartitioningStrategyPaused = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_PAUSED,
+	)
+)
+
+func (s AutoPartitioningStrategy) ToProto() Ydb_Topic.AutoPartitioningStrategy {
+	return Ydb_Topic.AutoPartitioningStrategy(s)
+}
+
+type AutoPartitioningWriteSpeedStrategy struct {
+	StabilizationWindow    time.Duration
+	UpUtilizationPercent   int32
+	DownUtilizationPercent int32
+}
+
+func (s *AutoPartitioningWriteSpeedStrategy) FromProto(proto *Ydb_Topic.AutoPartitioningWriteSpeedStrategy) {
+	s.StabilizationWindow = proto.GetStabilizationWindow().AsDuration()
+	s.UpUtilizationPercent = proto.GetUpUtilizationPercent()
+	s.DownUtilizationPercent = proto.GetDownUtilizationPercent()
+}
+
+func (s *AutoPartitioningWriteSpeedStrategy) ToProto() *Ydb_Topic.AutoPartitioningWriteSpeedStrategy {
+	res := &Ydb_Topic.AutoPartitioningWriteSpeedStrategy{
+		UpUtilizationPercent:   s.UpUtilizationPercent,
+		DownUtilizationPercent: s.DownUtilizationPercent,
+	}
+	if s.StabilizationWindow != 0 {
+		res.StabilizationWindow = durationpb.New(s.StabilizationWindow)
+	}
+
+	return res
+}
+
+type AutoPartitioningSettings struct {
+	AutoPartitioningStrategy           AutoPartitioningStrategy
+	AutoPartitioningWriteSpeedStrategy AutoPartitioningWriteSpeedStrategy
+}
+
+func (s *AutoPartitioningSettings) FromProto(proto *Ydb_Topic.AutoPartitioningSettings) {
+	s.AutoPartitioningStrategy = AutoPartitioningStrategy(proto.GetStrategy())
+	s.AutoPartitioningWriteSpeedStrategy.FromProto(proto.GetPartitionWriteSpeed())
+}
+
+func (s *AutoPartitioningSettings) ToProto() *Ydb_Topic.AutoPartitioningSettings {
+	return &Ydb_Topic.AutoPartitioningSettings{
+		Strategy:            s.AutoPartitioningStrategy.ToProto(),
+		PartitionWriteSpeed: s.AutoPartitioningWriteSpeedStrategy.ToProto(),
+	}
+}
+
 type PartitioningSettings struct {
-	MinActivePartitions int64
-	PartitionCountLimit int64
+	MinActivePartitions      int64
+	MaxActivePartitions      int64
+	PartitionCountLimit      int64
+	AutoPartitioningSettings AutoPartitioningSettings
 }
 
 func (s *PartitioningSettings) FromProto(proto *Ydb_Topic.PartitioningSettings) error {
@@ -57,26 +126,70 @@ func (s *PartitioningSettings) FromProto(proto *Ydb_Topic.PartitioningSettings)
 	}
 
 	s.MinActivePartitions = proto.GetMinActivePartitions()
+	s.MaxActivePartitions = proto.GetMaxActivePartitions()
 	s.PartitionCountLimit = proto.GetPartitionCountLimit()
+	s.AutoPartitioningSettings.FromProto(proto.GetAutoPartitioningSettings())
 
 	return nil
 }
 
 func (s *PartitioningSettings) ToProto() *Ydb_Topic.PartitioningSettings {
 	return &Ydb_Topic.PartitioningSettings{
-		MinActivePartitions: s.MinActivePartitions,
-		PartitionCountLimit: s.PartitionCountLimit,
+		MinActivePartitions:      s.MinActivePartitions,
+		MaxActivePartitions:      s.MaxActivePartitions,
+		PartitionCountLimit:      s.PartitionCountLimit,
+		AutoPartitioningSettings: s.AutoPartitioningSettings.ToProto(),
 	}
 }
 
 type AlterPartitioningSettings struct {
-	SetMinActivePartitions rawoptional.Int64
-	SetPartitionCountLimit rawoptional.Int64
+	SetMinActivePartitions        rawoptional.Int64
+	SetMaxActivePartitions        rawoptional.Int64
+	SetPartitionCountLimit        rawoptional.Int64
+	AlterAutoPartitioningSettings *AlterAutoPartitioningSettings
 }
 
 func (s *AlterPartitioningSettings) ToProto() *Ydb_Topic.AlterPartitioningSettings {
-	return &Ydb_Topic.AlterPartitioningSettings{
+	res := &Ydb_Topic.AlterPartitioningSettings{
 		SetMinActivePartitions: s.SetMinActivePartitions.ToProto(),
+		SetMaxActivePartitions: s.SetMaxActivePartitions.ToProto(),
 		SetPartitionCountLimit: s.SetPartitionCountLimit.ToProto(),
 	}
+	if s.AlterAutoPartitioningSettings != nil {
+		res.AlterAutoPartitioningSettings = s.AlterAutoPartitioningSettings.ToProto()
+	}
+
+	return res
+}
+
+type AlterAutoPartitioningSettings struct {
+	SetAutoPartitioningStrategy           *AutoPartitioningStrategy
+	SetAutoPartitioningWriteSpeedStrategy *AlterAutoPartitioningWriteSpeedStrategy
+}
+
+func (s *AlterAutoPartitioningSettings) ToProto() *Ydb_Topic.AlterAutoPartitioningSettings {
+	res := &Ydb_Topic.AlterAutoPartitioningSettings{}
+	if s.SetAutoPartitioningStrategy != nil {
+		strategy := s.SetAutoPartitioningStrategy.ToProto()
+		res.SetStrategy = &strategy
+	}
+	if s.SetAutoPartitioningWriteSpeedStrategy != nil {
+		res.SetPartitionWriteSpeed = s.SetAutoPartitioningWriteSpeedStrategy.ToProto()
+	}
+
+	return res
+}
+
+type AlterAutoPartitioningWriteSpeedStrategy struct {
+	SetStabilizationWindow    rawoptional.Duration
+	SetUpUtilizationPercent   rawoptional.Int32
+	SetDownUtilizationPercent rawoptional.Int32
+}
+
+func (s *AlterAutoPartitioningWriteSpeedStrategy) ToProto() *Ydb_Topic.AlterAutoPartitioningWriteSpeedStrategy {
+	return &Ydb_Topic.AlterAutoPartitioningWriteSpeedStrategy{
+		SetStabilizationWindow:    s.SetStabilizationWindow.ToProto(),
+		SetUpUtilizationPercent:   s.SetUpUtilizationPercent.ToProto(),
+		SetDownUtilizationPercent: s.SetDownUtilizationPercent.ToProto(),
+	}
 }
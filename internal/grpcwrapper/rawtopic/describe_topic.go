@@ -93,6 +93,12 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+	KeyRange           PartitionKeyRange
+}
+
+type PartitionKeyRange struct {
+	FromBound []byte
+	ToBound   []byte
 }
 
 func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_PartitionInfo) {
@@ -101,4 +107,6 @@ func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_Part
 
 	pi.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
 	pi.ParentPartitionIDs = clone.Int64Slice(proto.GetParentPartitionIds())
+	pi.KeyRange.FromBound = clone.ByteSlice(proto.GetKeyRange().GetFromBound())
+	pi.KeyRange.ToBound = clone.ByteSlice(proto.GetKeyRange().GetToBound())
 }
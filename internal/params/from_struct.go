@@ -0,0 +1,375 @@
+package params
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	errUnsupportedGoType = errors.New("unsupported go type")
+	errNotAStruct        = errors.New("not a struct or slice of structs")
+
+	typeTime     = reflect.TypeOf(time.Time{})
+	typeDuration = reflect.TypeOf(time.Duration(0))
+	typeUUID     = reflect.TypeOf([16]byte{})
+	typeValue    = reflect.TypeOf((*value.Value)(nil)).Elem()
+)
+
+type (
+	fromStructSettings struct {
+		TagName  string
+		ListName string
+	}
+	FromStructOption interface {
+		applyFromStructOption(settings *fromStructSettings)
+	}
+	fromStructTagName  string
+	fromStructListName string
+)
+
+func (name fromStructTagName) applyFromStructOption(settings *fromStructSettings) {
+	settings.TagName = string(name)
+}
+
+// WithTagName defines struct tag name for lookup parameter (and nested struct member) names.
+// Default tag name is "sql" as for query.Row.ScanStruct
+func WithTagName(name string) fromStructTagName {
+	return fromStructTagName(name)
+}
+
+func (name fromStructListName) applyFromStructOption(settings *fromStructSettings) {
+	settings.ListName = string(name)
+}
+
+// WithListName defines parameter name for slice of structs, passed to FromStruct. Default name is "$rows"
+func WithListName(name string) fromStructListName {
+	return fromStructListName(name)
+}
+
+// FromStruct makes named parameters from exported fields of struct v (or pointer to struct).
+// Parameter name is a field tag value (or field name if tag is not defined) with "$" prefix.
+// Fields with tag "-" are skipped.
+//
+// Go values are converted to YDB values recursively:
+//   - bool, int8..int64, uint8..uint64, float32, float64, string, []byte, [16]byte (UUID),
+//     time.Time (Timestamp) and time.Duration (Interval) into primitive values.
+//     int and uint are converted to Int32 and Uint32 as database/sql args
+//   - pointers into Optional values, nil pointers into typed NULL
+//   - slices and arrays into List values, nested structs into Struct values, maps into Dict values
+//   - value.Value (types.Value) as is
+//
+// Slice of structs becomes List<Struct<...>> which can be used as AS_TABLE source.
+// v may be slice (or array) of structs too, then it becomes one List<Struct<...>> parameter
+// with name "$rows" (see WithListName)
+func FromStruct(v interface{}, opts ...FromStructOption) (*Parameters, error) {
+	settings := fromStructSettings{
+		TagName:  "sql",
+		ListName: "$rows",
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.applyFromStructOption(&settings)
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if isSliceOfStructs(rv.Type()) {
+		list, err := goValue(rv, &settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		name := settings.ListName
+		if !strings.HasPrefix(name, "$") {
+			name = "$" + name
+		}
+
+		return &Parameters{Named(name, list)}, nil
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errNotAStruct, v))
+	}
+
+	fields := structFields(rv.Type(), settings.TagName)
+	parameters := make(Parameters, 0, len(fields))
+	for _, f := range fields {
+		fieldValue, err := goValue(rv.Field(f.index), &settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("field '%s': %w", f.name, err))
+		}
+		name := f.name
+		if name[0] != '$' {
+			name = "$" + name
+		}
+		parameters = append(parameters, Named(name, fieldValue))
+	}
+
+	return &parameters, nil
+}
+
+func isSliceOfStructs(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return false
+	}
+	itemType := t.Elem()
+	for itemType.Kind() == reflect.Pointer {
+		itemType = itemType.Elem()
+	}
+
+	return itemType.Kind() == reflect.Struct && itemType != typeTime
+}
+
+type structField struct {
+	name  string
+	index int
+}
+
+func structFields(t reflect.Type, tagName string) []structField {
+	fields := make([]structField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag, has := f.Tag.Lookup(tagName); has {
+			name = tag
+		}
+		if name == "-" || name == "" {
+			continue
+		}
+		fields = append(fields, structField{
+			name:  name,
+			index: i,
+		})
+	}
+
+	return fields
+}
+
+//nolint:funlen,gocyclo
+func goValue(rv reflect.Value, settings *fromStructSettings) (value.Value, error) {
+	t := rv.Type()
+
+	if t.Implements(typeValue) {
+		if (t.Kind() == reflect.Interface || t.Kind() == reflect.Pointer) && rv.IsNil() {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil %s", errUnsupportedGoType, t.String()))
+		}
+
+		return rv.Interface().(value.Value), nil //nolint:forcetypeassert
+	}
+
+	switch t {
+	case typeTime:
+		return value.TimestampValueFromTime(rv.Interface().(time.Time)), nil //nolint:forcetypeassert
+	case typeDuration:
+		return value.IntervalValueFromDuration(time.Duration(rv.Int())), nil
+	case typeUUID:
+		return value.UUIDValue(rv.Interface().([16]byte)), nil //nolint:forcetypeassert
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return value.BoolValue(rv.Bool()), nil
+	case reflect.Int8:
+		return value.Int8Value(int8(rv.Int())), nil
+	case reflect.Int16:
+		return value.Int16Value(int16(rv.Int())), nil
+	case reflect.Int32, reflect.Int:
+		return value.Int32Value(int32(rv.Int())), nil
+	case reflect.Int64:
+		return value.Int64Value(rv.Int()), nil
+	case reflect.Uint8:
+		return value.Uint8Value(uint8(rv.Uint())), nil
+	case reflect.Uint16:
+		return value.Uint16Value(uint16(rv.Uint())), nil
+	case reflect.Uint32, reflect.Uint:
+		return value.Uint32Value(uint32(rv.Uint())), nil
+	case reflect.Uint64:
+		return value.Uint64Value(rv.Uint()), nil
+	case reflect.Float32:
+		return value.FloatValue(float32(rv.Float())), nil
+	case reflect.Float64:
+		return value.DoubleValue(rv.Float()), nil
+	case reflect.String:
+		return value.TextValue(rv.String()), nil
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil %s", errUnsupportedGoType, t.String()))
+		}
+
+		return goValue(rv.Elem(), settings)
+	case reflect.Pointer:
+		if rv.IsNil() {
+			innerType, err := goType(t.Elem(), settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return value.NullValue(innerType), nil
+		}
+		v, err := goValue(rv.Elem(), settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return value.OptionalValue(v), nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return value.BytesValue(rv.Bytes()), nil
+		}
+		if rv.Len() == 0 {
+			listType, err := goType(t, settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return value.ZeroValue(listType), nil
+		}
+		items := make([]value.Value, rv.Len())
+		for i := range items {
+			item, err := goValue(rv.Index(i), settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("item #%d: %w", i, err))
+			}
+			items[i] = item
+		}
+
+		return value.ListValue(items...), nil
+	case reflect.Map:
+		if rv.Len() == 0 {
+			dictType, err := goType(t, settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return value.ZeroValue(dictType), nil
+		}
+		fields := make([]value.DictValueField, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			k, err := goValue(iter.Key(), settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("map key '%v': %w", iter.Key(), err))
+			}
+			v, err := goValue(iter.Value(), settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("map value for key '%v': %w", iter.Key(), err))
+			}
+			fields = append(fields, value.DictValueField{K: k, V: v})
+		}
+
+		return value.DictValue(fields...), nil
+	case reflect.Struct:
+		fields := structFields(t, settings.TagName)
+		members := make([]value.StructValueField, 0, len(fields))
+		for _, f := range fields {
+			v, err := goValue(rv.Field(f.index), settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("struct field '%s': %w", f.name, err))
+			}
+			members = append(members, value.StructValueField{Name: f.name, V: v})
+		}
+
+		return value.StructValue(members...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedGoType, t.String()))
+	}
+}
+
+// goType returns YDB type for go type. It used for typed NULL and empty containers
+//
+//nolint:funlen,gocyclo
+func goType(t reflect.Type, settings *fromStructSettings) (types.Type, error) {
+	switch t {
+	case typeTime:
+		return types.Timestamp, nil
+	case typeDuration:
+		return types.Interval, nil
+	case typeUUID:
+		return types.UUID, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.Bool, nil
+	case reflect.Int8:
+		return types.Int8, nil
+	case reflect.Int16:
+		return types.Int16, nil
+	case reflect.Int32, reflect.Int:
+		return types.Int32, nil
+	case reflect.Int64:
+		return types.Int64, nil
+	case reflect.Uint8:
+		return types.Uint8, nil
+	case reflect.Uint16:
+		return types.Uint16, nil
+	case reflect.Uint32, reflect.Uint:
+		return types.Uint32, nil
+	case reflect.Uint64:
+		return types.Uint64, nil
+	case reflect.Float32:
+		return types.Float, nil
+	case reflect.Float64:
+		return types.Double, nil
+	case reflect.String:
+		return types.Text, nil
+	case reflect.Pointer:
+		innerType, err := goType(t.Elem(), settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewOptional(innerType), nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return types.Bytes, nil
+		}
+		itemType, err := goType(t.Elem(), settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewList(itemType), nil
+	case reflect.Map:
+		keyType, err := goType(t.Key(), settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		valueType, err := goType(t.Elem(), settings)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewDict(keyType, valueType), nil
+	case reflect.Struct:
+		fields := structFields(t, settings.TagName)
+		members := make([]types.StructField, 0, len(fields))
+		for _, f := range fields {
+			fieldType, err := goType(t.Field(f.index).Type, settings)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("struct field '%s': %w", f.name, err))
+			}
+			members = append(members, types.StructField{Name: f.name, T: fieldType})
+		}
+		// same order of members as value.StructValue makes
+		sort.Slice(members, func(i, j int) bool {
+			return members[i].Name < members[j].Name
+		})
+
+		return types.NewStruct(members...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedGoType, t.String()))
+	}
+}
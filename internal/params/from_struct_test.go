@@ -0,0 +1,271 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type fromStructRow struct {
+	ID      uint64     `sql:"id"`
+	Name    *string    `sql:"name"`
+	Tags    []string   `sql:"tags"`
+	Updated *time.Time `sql:"updated_at"`
+	Skip    string     `sql:"-"`
+	skip    string     //nolint:unused
+}
+
+func TestFromStruct(t *testing.T) {
+	name := "test"
+	for _, tt := range []struct {
+		name   string
+		src    interface{}
+		opts   []FromStructOption
+		params *Parameters
+		err    error
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				A bool
+				B int8
+				C int16
+				D int32
+				E int64
+				F uint8
+				G uint16
+				H uint32
+				I uint64
+				J float32
+				K float64
+				L string
+				M []byte
+				N [16]byte
+				O time.Time
+				P time.Duration
+				Q int
+				R uint
+			}{
+				A: true,
+				B: 1,
+				C: 2,
+				D: 3,
+				E: 4,
+				F: 5,
+				G: 6,
+				H: 7,
+				I: 8,
+				J: 9.5,
+				K: 10.5,
+				L: "11",
+				M: []byte("12"),
+				N: [16]byte{13},
+				O: time.Unix(14, 0),
+				P: 15 * time.Second,
+				Q: 16,
+				R: 17,
+			},
+			params: &Parameters{
+				Named("$A", value.BoolValue(true)),
+				Named("$B", value.Int8Value(1)),
+				Named("$C", value.Int16Value(2)),
+				Named("$D", value.Int32Value(3)),
+				Named("$E", value.Int64Value(4)),
+				Named("$F", value.Uint8Value(5)),
+				Named("$G", value.Uint16Value(6)),
+				Named("$H", value.Uint32Value(7)),
+				Named("$I", value.Uint64Value(8)),
+				Named("$J", value.FloatValue(9.5)),
+				Named("$K", value.DoubleValue(10.5)),
+				Named("$L", value.TextValue("11")),
+				Named("$M", value.BytesValue([]byte("12"))),
+				Named("$N", value.UUIDValue([16]byte{13})),
+				Named("$O", value.TimestampValueFromTime(time.Unix(14, 0))),
+				Named("$P", value.IntervalValueFromDuration(15*time.Second)),
+				Named("$Q", value.Int32Value(16)),
+				Named("$R", value.Uint32Value(17)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: &fromStructRow{
+				ID:   1,
+				Name: &name,
+				Tags: []string{"a", "b"},
+				Skip: "skip",
+			},
+			params: &Parameters{
+				Named("$id", value.Uint64Value(1)),
+				Named("$name", value.OptionalValue(value.TextValue("test"))),
+				Named("$tags", value.ListValue(value.TextValue("a"), value.TextValue("b"))),
+				Named("$updated_at", value.NullValue(types.Timestamp)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				Rows []fromStructRow `sql:"$rows"`
+			}{
+				Rows: []fromStructRow{
+					{ID: 1, Name: &name},
+					{ID: 2, Tags: []string{"a"}},
+				},
+			},
+			params: &Parameters{
+				Named("$rows", value.ListValue(
+					value.StructValue(
+						value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+						value.StructValueField{Name: "name", V: value.OptionalValue(value.TextValue("test"))},
+						value.StructValueField{Name: "tags", V: value.ZeroValue(types.NewList(types.Text))},
+						value.StructValueField{Name: "updated_at", V: value.NullValue(types.Timestamp)},
+					),
+					value.StructValue(
+						value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+						value.StructValueField{Name: "name", V: value.NullValue(types.Text)},
+						value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("a"))},
+						value.StructValueField{Name: "updated_at", V: value.NullValue(types.Timestamp)},
+					),
+				)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				Rows []fromStructRow  `sql:"rows"`
+				Dict map[string]int64 `sql:"dict"`
+				Row  *fromStructRow   `sql:"row"`
+			}{},
+			params: &Parameters{
+				Named("$rows", value.ZeroValue(types.NewList(types.NewStruct(
+					types.StructField{Name: "id", T: types.Uint64},
+					types.StructField{Name: "name", T: types.NewOptional(types.Text)},
+					types.StructField{Name: "tags", T: types.NewList(types.Text)},
+					types.StructField{Name: "updated_at", T: types.NewOptional(types.Timestamp)},
+				)))),
+				Named("$dict", value.ZeroValue(types.NewDict(types.Text, types.Int64))),
+				Named("$row", value.NullValue(types.NewStruct(
+					types.StructField{Name: "id", T: types.Uint64},
+					types.StructField{Name: "name", T: types.NewOptional(types.Text)},
+					types.StructField{Name: "tags", T: types.NewList(types.Text)},
+					types.StructField{Name: "updated_at", T: types.NewOptional(types.Timestamp)},
+				))),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				Dict map[string]int64 `sql:"dict"`
+			}{
+				Dict: map[string]int64{"a": 1},
+			},
+			params: &Parameters{
+				Named("$dict", value.DictValue(
+					value.DictValueField{K: value.TextValue("a"), V: value.Int64Value(1)},
+				)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				V value.Value `sql:"v"`
+				I interface{} `sql:"i"`
+			}{
+				V: value.JSONValue(`{}`),
+				I: int64(1),
+			},
+			params: &Parameters{
+				Named("$v", value.JSONValue(`{}`)),
+				Named("$i", value.Int64Value(1)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				ID uint64 `json:"id"`
+			}{
+				ID: 1,
+			},
+			opts: []FromStructOption{WithTagName("json")},
+			params: &Parameters{
+				Named("$id", value.Uint64Value(1)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				C chan int
+			}{},
+			err: errUnsupportedGoType,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: struct {
+				V value.Value
+			}{},
+			err: errUnsupportedGoType,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: []fromStructRow{
+				{ID: 1, Name: &name},
+				{ID: 2, Tags: []string{"a"}},
+			},
+			params: &Parameters{
+				Named("$rows", value.ListValue(
+					value.StructValue(
+						value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+						value.StructValueField{Name: "name", V: value.OptionalValue(value.TextValue("test"))},
+						value.StructValueField{Name: "tags", V: value.ZeroValue(types.NewList(types.Text))},
+						value.StructValueField{Name: "updated_at", V: value.NullValue(types.Timestamp)},
+					),
+					value.StructValue(
+						value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+						value.StructValueField{Name: "name", V: value.NullValue(types.Text)},
+						value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("a"))},
+						value.StructValueField{Name: "updated_at", V: value.NullValue(types.Timestamp)},
+					),
+				)),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: &[]struct {
+				ID uint64 `json:"id"`
+			}{},
+			opts: []FromStructOption{WithTagName("json"), WithListName("items")},
+			params: &Parameters{
+				Named("$items", value.ZeroValue(types.NewList(types.NewStruct(
+					types.StructField{Name: "id", T: types.Uint64},
+				)))),
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  []int64{1},
+			err:  errNotAStruct,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  []time.Time{},
+			err:  errNotAStruct,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := FromStruct(tt.src, tt.opts...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.params.String(), params.String())
+				for i := range *tt.params {
+					require.Equal(t, Declare((*tt.params)[i]), Declare((*params)[i]))
+				}
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/jonboulle/clockwork"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -21,6 +23,10 @@ type (
 		IsAlive() bool
 		Close(ctx context.Context) error
 	}
+	// nodeItem is an optional interface of item for node-affine selection of idle items
+	nodeItem interface {
+		NodeID() uint32
+	}
 	ItemConstraint[T any] interface {
 		*T
 		Item
@@ -29,12 +35,16 @@ type (
 		trace          *Trace
 		clock          clockwork.Clock
 		limit          int
+		minSize        int
 		createTimeout  time.Duration
 		createItem     func(ctx context.Context) (PT, error)
 		closeTimeout   time.Duration
 		closeItem      func(ctx context.Context, item PT)
 		idleTimeToLive time.Duration
 		itemUsageLimit uint64
+
+		statsInterval time.Duration
+		onStats       func(stats Stats)
 	}
 	itemInfo[PT ItemConstraint[T], T any] struct {
 		idle       *xlist.Element[PT]
@@ -58,6 +68,10 @@ type (
 		waitQ            xlist.List[*chan PT]
 		waitChPool       waitChPool[PT, T]
 
+		refill    chan struct{}
+		ready     chan struct{}
+		readyOnce sync.Once
+
 		done chan struct{}
 	}
 	Option[PT ItemConstraint[T], T any] func(c *Config[PT, T])
@@ -95,6 +109,22 @@ func WithLimit[PT ItemConstraint[T], T any](size int) Option[PT, T] {
 	}
 }
 
+// WithMinSize defines minimum count of items which pool keeps opened (idle or in use).
+// Pool prefills items in background after New and refills them when items closed
+func WithMinSize[PT ItemConstraint[T], T any](size int) Option[PT, T] {
+	return func(c *Config[PT, T]) {
+		c.minSize = size
+	}
+}
+
+// WithStatsCallback defines callback which called with snapshot of pool stats periodically with interval
+func WithStatsCallback[PT ItemConstraint[T], T any](interval time.Duration, onStats func(stats Stats)) Option[PT, T] {
+	return func(c *Config[PT, T]) {
+		c.statsInterval = interval
+		c.onStats = onStats
+	}
+}
+
 func WithItemUsageLimit[PT ItemConstraint[T], T any](itemUsageLimit uint64) Option[PT, T] {
 	return func(c *Config[PT, T]) {
 		c.itemUsageLimit = itemUsageLimit
@@ -142,7 +172,9 @@ func New[PT ItemConstraint[T], T any](
 				return &ch
 			},
 		},
-		done: make(chan struct{}),
+		refill: make(chan struct{}, 1),
+		ready:  make(chan struct{}),
+		done:   make(chan struct{}),
 	}
 
 	for _, opt := range opts {
@@ -151,6 +183,10 @@ func New[PT ItemConstraint[T], T any](
 		}
 	}
 
+	if p.config.minSize > p.config.limit {
+		p.config.minSize = p.config.limit
+	}
+
 	if onNew := p.config.trace.OnNew; onNew != nil {
 		onDone := onNew(&ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/pool.New"),
@@ -169,9 +205,115 @@ func New[PT ItemConstraint[T], T any](
 		p.closeItem = makeAsyncCloseItemFunc[PT, T](p)
 	}
 
+	if p.config.minSize > 0 {
+		go p.prewarm(xcontext.ValueOnly(ctx))
+	} else {
+		p.setReady()
+	}
+
+	if p.config.statsInterval > 0 && p.config.onStats != nil {
+		go p.reportStats()
+	}
+
 	return p
 }
 
+// Ready returns channel which closed when pool has prefilled minimal count of items
+// (immediately if minimal size of pool is not defined)
+func (p *Pool[PT, T]) Ready() <-chan struct{} {
+	return p.ready
+}
+
+func (p *Pool[PT, T]) setReady() {
+	p.readyOnce.Do(func() {
+		close(p.ready)
+	})
+}
+
+// prewarm creates items in background until pool has minimal count of items.
+// After that prewarm waits signal about closed items for refill pool
+func (p *Pool[PT, T]) prewarm(ctx context.Context) {
+	for attempt := 0; ; {
+		if err := p.fill(ctx); err == nil {
+			p.setReady()
+			attempt = 0
+
+			select {
+			case <-p.done:
+				return
+			case <-p.refill:
+				continue
+			}
+		}
+
+		t := p.config.clock.NewTimer(backoff.Delay(backoff.TypeFast, attempt))
+		attempt++
+
+		select {
+		case <-p.done:
+			t.Stop()
+
+			return
+		case <-t.Chan():
+		case <-p.refill:
+			t.Stop()
+		}
+	}
+}
+
+// reportStats calls stats callback periodically until pool closed
+func (p *Pool[PT, T]) reportStats() {
+	ticker := p.config.clock.NewTicker(p.config.statsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.Chan():
+			p.config.onStats(p.Stats())
+		}
+	}
+}
+
+// fill creates missing items concurrently and puts them into idle.
+// Items which are creating now (on demand or by previous fill) are not missing
+func (p *Pool[PT, T]) fill(ctx context.Context) error {
+	missing := xsync.WithLock(&p.mu, func() int {
+		return p.config.minSize - len(p.index) - p.createInProgress
+	})
+	if missing <= 0 {
+		return nil
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, missing)
+	)
+	wg.Add(missing)
+	for i := 0; i < missing; i++ {
+		go func(i int) {
+			defer wg.Done()
+
+			item, err := p.createItem(ctx)
+			if err != nil {
+				errs[i] = err
+
+				return
+			}
+
+			errs[i] = p.putItem(ctx, item)
+		}(i)
+	}
+	wg.Wait()
+
+	if err := xerrors.Join(errs...); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
 // defaultCreateItem returns a new item
 func defaultCreateItem[T any, PT ItemConstraint[T]](context.Context) (PT, error) {
 	var item T
@@ -309,7 +451,16 @@ func makeAsyncCloseItemFunc[PT ItemConstraint[T], T any](
 }
 
 func (p *Pool[PT, T]) changeState(changeState func() Stats) {
-	if stats, onChange := changeState(), p.config.trace.OnChange; onChange != nil {
+	stats := changeState()
+
+	if p.config.minSize > 0 && stats.Index < p.config.minSize {
+		select {
+		case p.refill <- struct{}{}:
+		default:
+		}
+	}
+
+	if onChange := p.config.trace.OnChange; onChange != nil {
 		onChange(stats)
 	}
 }
@@ -488,6 +639,40 @@ func (p *Pool[PT, T]) removeFirstIdle() PT {
 	return idle
 }
 
+// removeIdleOnNode removes first idle item on node with nodeID from idle list.
+// p.mu must be held.
+func (p *Pool[PT, T]) removeIdleOnNode(nodeID uint32) PT {
+	for el := p.idle.Front(); el != nil; el = el.Next() {
+		if item, has := any(el.Value).(nodeItem); has && item.NodeID() == nodeID {
+			idle := el.Value
+			info := p.removeIdle(idle)
+			p.index[idle] = info
+
+			return idle
+		}
+	}
+
+	return nil
+}
+
+// removeIdleFor removes idle item with respect of preferred node from context (balancers.WithNodeID).
+// If pool has no idle item on preferred node and new item can be created - removeIdle returns nil
+// for create new item on preferred node. Otherwise, removeIdleFor falls back to first idle item.
+// p.mu must be held.
+func (p *Pool[PT, T]) removeIdleFor(ctx context.Context) PT {
+	if nodeID, has := endpoint.ContextNodeID(ctx); has {
+		if item := p.removeIdleOnNode(nodeID); item != nil {
+			return item
+		}
+
+		if len(p.index)+p.createInProgress < p.config.limit {
+			return nil
+		}
+	}
+
+	return p.removeFirstIdle()
+}
+
 // p.mu must be held.
 func (p *Pool[PT, T]) notifyAboutIdle(idle PT) (notified bool) {
 	for el := p.waitQ.Front(); el != nil; el = p.waitQ.Front() {
@@ -572,6 +757,9 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (item PT, finalErr error) { /
 		start   = p.config.clock.Now()
 		attempt int
 		lastErr error
+
+		// preferNode is false after failed create of item on preferred node from context
+		preferNode = true
 	)
 
 	if onGet := p.config.trace.OnGet; onGet != nil {
@@ -593,7 +781,11 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (item PT, finalErr error) { /
 		}
 
 		if item := xsync.WithLock(&p.mu, func() PT { //nolint:nestif
-			return p.removeFirstIdle()
+			if !preferNode {
+				return p.removeFirstIdle()
+			}
+
+			return p.removeIdleFor(ctx)
 		}); item != nil {
 			if item.IsAlive() {
 				info := xsync.WithLock(&p.mu, func() itemInfo[PT, T] {
@@ -630,6 +822,16 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (item PT, finalErr error) { /
 			return item, nil
 		}
 
+		// preferred node is unavailable or pool is full, idle item on other node is better than waiting
+		if _, has := endpoint.ContextNodeID(ctx); has && preferNode {
+			preferNode = false
+			if xsync.WithRLock(&p.mu, func() bool { return p.idle.Len() > 0 }) {
+				lastErr = err
+
+				continue
+			}
+		}
+
 		if !isRetriable(err) {
 			return nil, xerrors.WithStackTrace(xerrors.Join(err, lastErr))
 		}
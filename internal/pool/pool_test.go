@@ -20,6 +20,7 @@ import (
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -32,7 +33,8 @@ import (
 
 type (
 	testItem struct {
-		v uint32
+		v      uint32
+		nodeID uint32
 
 		closed bytes.Buffer
 
@@ -113,6 +115,10 @@ func (t *testItem) ID() string {
 	return ""
 }
 
+func (t *testItem) NodeID() uint32 {
+	return t.nodeID
+}
+
 func (t *testItem) Close(context.Context) error {
 	if t.closed.Len() > 0 {
 		debug.PrintStack()
@@ -222,6 +228,148 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 			require.NoError(t, err)
 			require.EqualValues(t, p.config.limit, atomic.LoadInt64(&newCounter))
 		})
+		t.Run("WithStatsCallback", func(t *testing.T) {
+			clock := clockwork.NewFakeClock()
+			snapshots := make(chan Stats, 1)
+			p := New(rootCtx,
+				WithLimit[*testItem, testItem](3),
+				WithClock[*testItem, testItem](clock),
+				WithStatsCallback[*testItem, testItem](time.Second, func(stats Stats) {
+					snapshots <- stats
+				}),
+				WithTrace[*testItem, testItem](defaultTrace),
+			)
+			item := mustGetItem(t, p)
+			clock.BlockUntil(1)
+			clock.Advance(time.Second)
+			require.Equal(t, Stats{
+				Limit: 3,
+				Index: 1,
+			}, <-snapshots)
+			mustPutItem(t, p, item)
+			clock.Advance(time.Second)
+			require.Equal(t, Stats{
+				Limit: 3,
+				Index: 1,
+				Idle:  1,
+			}, <-snapshots)
+			require.NoError(t, p.Close(rootCtx))
+		})
+		t.Run("WithMinSize", func(t *testing.T) {
+			t.Run("Prewarm", func(t *testing.T) {
+				var newCounter int64
+				p := New(rootCtx,
+					WithLimit[*testItem, testItem](5),
+					WithMinSize[*testItem, testItem](3),
+					WithCreateItemFunc(func(context.Context) (*testItem, error) {
+						if atomic.AddInt64(&newCounter, 1) <= 2 {
+							return nil, xerrors.Retryable(errors.New("test"))
+						}
+						var v testItem
+
+						return &v, nil
+					}),
+					WithTrace[*testItem, testItem](defaultTrace),
+				)
+				defer func() {
+					_ = p.Close(rootCtx)
+				}()
+				select {
+				case <-p.Ready():
+				case <-time.After(time.Second):
+					t.Fatal("pool is not ready")
+				}
+				stats := p.Stats()
+				require.GreaterOrEqual(t, stats.Index, 3)
+				require.Equal(t, stats.Index, stats.Idle)
+			})
+			t.Run("GreaterThanLimit", func(t *testing.T) {
+				p := New(rootCtx,
+					WithLimit[*testItem, testItem](2),
+					WithMinSize[*testItem, testItem](3),
+					WithTrace[*testItem, testItem](defaultTrace),
+				)
+				defer func() {
+					_ = p.Close(rootCtx)
+				}()
+				require.EqualValues(t, 2, p.config.minSize)
+				<-p.Ready()
+				require.Equal(t, 2, p.Stats().Index)
+			})
+			t.Run("Refill", func(t *testing.T) {
+				var (
+					alive      atomic.Bool
+					newCounter int64
+				)
+				alive.Store(true)
+				p := New(rootCtx,
+					WithLimit[*testItem, testItem](5),
+					WithMinSize[*testItem, testItem](2),
+					WithCreateItemFunc(func(context.Context) (*testItem, error) {
+						atomic.AddInt64(&newCounter, 1)
+
+						return &testItem{
+							onIsAlive: alive.Load,
+						}, nil
+					}),
+					WithTrace[*testItem, testItem](defaultTrace),
+				)
+				defer func() {
+					_ = p.Close(rootCtx)
+				}()
+				<-p.Ready()
+				require.EqualValues(t, 2, atomic.LoadInt64(&newCounter))
+				item := mustGetItem(t, p)
+				alive.Store(false)
+				require.ErrorIs(t, p.putItem(rootCtx, item), errItemIsNotAlive)
+				alive.Store(true)
+				require.Eventually(t, func() bool {
+					return atomic.LoadInt64(&newCounter) == 3 && p.Stats().Index == 2
+				}, time.Second, time.Millisecond)
+			})
+			t.Run("FillWithCreateInProgress", func(t *testing.T) {
+				var (
+					newCounter int64
+					release    = make(chan struct{})
+				)
+				p := New(rootCtx,
+					WithLimit[*testItem, testItem](5),
+					WithCreateItemFunc(func(context.Context) (*testItem, error) {
+						if atomic.AddInt64(&newCounter, 1) == 1 {
+							<-release
+						}
+
+						return &testItem{}, nil
+					}),
+					WithTrace[*testItem, testItem](defaultTrace),
+				)
+				defer func() {
+					_ = p.Close(rootCtx)
+				}()
+
+				created := make(chan error)
+				go func() {
+					_, err := p.createItem(rootCtx)
+					created <- err
+				}()
+				require.Eventually(t, func() bool {
+					return p.Stats().CreateInProgress == 1
+				}, time.Second, time.Millisecond)
+
+				p.config.minSize = 2
+				require.NoError(t, p.fill(rootCtx))
+				close(release)
+				require.NoError(t, <-created)
+				require.EqualValues(t, 2, atomic.LoadInt64(&newCounter))
+			})
+			t.Run("WithoutMinSize", func(t *testing.T) {
+				p := New[*testItem, testItem](rootCtx,
+					WithTrace[*testItem, testItem](defaultTrace),
+				)
+				<-p.Ready()
+				require.Zero(t, p.Stats().Index)
+			})
+		})
 	})
 	t.Run("Close", func(t *testing.T) {
 		counter := 0
@@ -694,6 +842,91 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 			}
 		})
 	})
+	t.Run("NodeAffinity", func(t *testing.T) {
+		p := New(rootCtx,
+			WithLimit[*testItem, testItem](3),
+			WithCreateItemFunc(func(ctx context.Context) (*testItem, error) {
+				// balancer creates item on preferred node
+				nodeID, _ := endpoint.ContextNodeID(ctx)
+
+				return &testItem{nodeID: nodeID}, nil
+			}),
+			WithTrace[*testItem, testItem](defaultTrace),
+		)
+		defer func() {
+			_ = p.Close(rootCtx)
+		}()
+		getItem := func(nodeID uint32) *testItem {
+			item, err := p.getItem(endpoint.WithNodeID(rootCtx, nodeID))
+			require.NoError(t, err)
+
+			return item
+		}
+		item1 := getItem(1)
+		mustPutItem(t, p, item1)
+		// new item created on preferred node while pool is not full
+		item2 := getItem(2)
+		require.NotSame(t, item1, item2)
+		require.EqualValues(t, 2, item2.NodeID())
+		mustPutItem(t, p, item2)
+		// idle item on preferred node
+		item := getItem(2)
+		require.Same(t, item2, item)
+		mustPutItem(t, p, item)
+		item3 := getItem(3)
+		require.EqualValues(t, 3, item3.NodeID())
+		mustPutItem(t, p, item3)
+		// fallback to first idle item if pool is full
+		item = getItem(4)
+		require.Same(t, item1, item)
+		mustPutItem(t, p, item)
+		require.Equal(t, 3, p.Stats().Index)
+	})
+	t.Run("NodeAffinityUnavailableNode", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			err  error
+		}{
+			{
+				name: "Retriable",
+				err:  xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+			},
+			{
+				name: "NonRetriable",
+				err:  errors.New("node unavailable"),
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				p := New(rootCtx,
+					WithLimit[*testItem, testItem](3),
+					WithCreateItemFunc(func(ctx context.Context) (*testItem, error) {
+						nodeID, _ := endpoint.ContextNodeID(ctx)
+						if nodeID == 2 {
+							return nil, tt.err
+						}
+
+						return &testItem{nodeID: nodeID}, nil
+					}),
+					WithCreateItemTimeout[*testItem, testItem](time.Hour),
+				)
+				defer func() {
+					_ = p.Close(rootCtx)
+				}()
+				item1, err := p.getItem(endpoint.WithNodeID(rootCtx, 1))
+				require.NoError(t, err)
+				mustPutItem(t, p, item1)
+
+				// idle item on other node instead of wait of create on unavailable preferred node
+				ctx, cancel := xcontext.WithTimeout(endpoint.WithNodeID(rootCtx, 2), time.Second)
+				defer cancel()
+				item, err := p.getItem(ctx)
+				require.NoError(t, err)
+				require.Same(t, item1, item)
+				mustPutItem(t, p, item)
+				require.Equal(t, 1, p.Stats().Index)
+			})
+		}
+	})
 	t.Run("Item", func(t *testing.T) {
 		t.Run("Close", func(t *testing.T) {
 			xtest.TestManyTimes(t, func(t testing.TB) {
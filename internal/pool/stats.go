@@ -1,9 +1,7 @@
 package pool
 
-type Stats struct {
-	Limit            int
-	Index            int
-	Idle             int
-	Wait             int
-	CreateInProgress int
-}
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool/stats"
+)
+
+type Stats = stats.Stats
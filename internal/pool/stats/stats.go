@@ -0,0 +1,15 @@
+package stats
+
+// Stats is a snapshot of pool state
+type Stats struct {
+	// Limit is an upper bound of items in pool
+	Limit int
+	// Index is a count of items in pool (idle and in use)
+	Index int
+	// Idle is a count of idle items in pool
+	Idle int
+	// Wait is a count of waiters of idle item
+	Wait int
+	// CreateInProgress is a count of items which creating now
+	CreateInProgress int
+}
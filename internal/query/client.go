@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
@@ -11,14 +12,17 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/explain"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/session"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -30,8 +34,12 @@ import (
 //go:generate mockgen -destination grpc_client_mock_test.go --typed -package query -write_package_comment=false github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1 QueryServiceClient,QueryService_AttachSessionClient,QueryService_ExecuteQueryClient
 
 var (
-	_ query.Client = (*Client)(nil)
-	_ sessionPool  = (*pool.Pool[*Session, Session])(nil)
+	_ query.Client            = (*Client)(nil)
+	_ query.Explainer         = (*Client)(nil)
+	_ query.ScriptStarter     = (*Client)(nil)
+	_ query.ReadinessWaiter   = (*Client)(nil)
+	_ query.PoolStatsProvider = (*Client)(nil)
+	_ sessionPool             = (*pool.Pool[*Session, Session])(nil)
 )
 
 type (
@@ -39,13 +47,17 @@ type (
 		closer.Closer
 
 		Stats() pool.Stats
+		Ready() <-chan struct{}
 		With(ctx context.Context, f func(ctx context.Context, s *Session) error, opts ...retry.Option) error
 	}
 	Client struct {
 		config *config.Config
+		cc     grpc.ClientConnInterface
 		client Ydb_Query_V1.QueryServiceClient
 		pool   sessionPool
 
+		operations Ydb_Operation_V1.OperationServiceClient
+
 		done chan struct{}
 	}
 )
@@ -174,6 +186,10 @@ func (c *Client) ExecuteScript(
 		),
 	}
 
+	if err = settings.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	request, grpcOpts := executeQueryScriptRequest(a, q, settings)
 
 	op, err = executeScript(ctx, c.client, request, grpcOpts...)
@@ -184,6 +200,32 @@ func (c *Client) ExecuteScript(
 	return op, nil
 }
 
+func (c *Client) StartScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.Execute,
+) (query.ScriptExecution, error) {
+	op, err := c.ExecuteScript(ctx, q, ttl, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return newScriptExecution(op.ID, c.client, c.operations), nil
+}
+
+func (c *Client) Stats() query.PoolStats {
+	return c.pool.Stats()
+}
+
+func (c *Client) WaitReady(ctx context.Context) error {
+	select {
+	case <-c.done:
+		return xerrors.WithStackTrace(errClosedClient)
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	case <-c.pool.Ready():
+		return nil
+	}
+}
+
 func (c *Client) Close(ctx context.Context) error {
 	close(c.done)
 
@@ -268,6 +310,11 @@ func doTx(
 		}
 
 		defer func() {
+			if t, ok := tx.(*Transaction); ok && opErr != nil && !t.completed {
+				// rollback callbacks receives the reason of failed attempt instead of ErrTransactionRollingBack
+				t.callOnCompleted(opErr)
+			}
+
 			_ = tx.Rollback(ctx)
 
 			if opErr != nil {
@@ -375,6 +422,58 @@ func (c *Client) Exec(ctx context.Context, q string, opts ...options.Execute) (f
 	return nil
 }
 
+// Explain explains query with explain exec mode and returns query AST and parsed query plan
+func (c *Client) Explain(ctx context.Context, q string, opts ...options.Execute) (
+	_ *query.Explanation, finalErr error,
+) {
+	ctx, cancel := xcontext.WithDone(ctx, c.done)
+	defer cancel()
+
+	onDone := trace.QueryOnExec(c.config.Trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).Explain"),
+		q,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	explanation, err := clientExplain(ctx, c.pool, q, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return explanation, nil
+}
+
+func clientExplain(ctx context.Context, pool sessionPool, q string, opts ...options.Execute) (
+	*query.Explanation, error,
+) {
+	var ast, plan string
+	err := clientExec(ctx, pool, q, append(append([]options.Execute(nil), opts...),
+		options.WithExecMode(options.ExecModeExplain),
+		options.WithStatsMode(options.StatsModeNone, func(queryStats stats.QueryStats) {
+			ast = queryStats.QueryAST()
+			plan = queryStats.QueryPlan()
+		}),
+		// explain have no side effects
+		options.WithIdempotent(),
+	)...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	parsedPlan, err := explain.Parse(plan)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &query.Explanation{
+		AST:     ast,
+		Plan:    parsedPlan,
+		RawPlan: plan,
+	}, nil
+}
+
 func clientQuery(ctx context.Context, pool sessionPool, q string, opts ...options.Execute) (
 	r query.Result, err error,
 ) {
@@ -517,46 +616,69 @@ func New(ctx context.Context, cc grpc.ClientConnInterface, cfg *config.Config) *
 	)
 	defer onDone()
 
-	client := Ydb_Query_V1.NewQueryServiceClient(cc)
-
-	return &Client{
+	client := &Client{
 		config: cfg,
-		client: client,
-		done:   make(chan struct{}),
-		pool: pool.New(ctx,
-			pool.WithLimit[*Session, Session](cfg.PoolLimit()),
-			pool.WithItemUsageLimit[*Session, Session](cfg.PoolSessionUsageLimit()),
-			pool.WithTrace[*Session, Session](poolTrace(cfg.Trace())),
-			pool.WithCreateItemTimeout[*Session, Session](cfg.SessionCreateTimeout()),
-			pool.WithCloseItemTimeout[*Session, Session](cfg.SessionDeleteTimeout()),
-			pool.WithIdleTimeToLive[*Session, Session](cfg.SessionIdleTimeToLive()),
-			pool.WithCreateItemFunc(func(ctx context.Context) (_ *Session, err error) {
-				var (
-					createCtx    context.Context
-					cancelCreate context.CancelFunc
-				)
-				if d := cfg.SessionCreateTimeout(); d > 0 {
-					createCtx, cancelCreate = xcontext.WithTimeout(ctx, d)
-				} else {
-					createCtx, cancelCreate = xcontext.WithCancel(ctx)
-				}
-				defer cancelCreate()
-
-				s, err := createSession(createCtx, client,
-					session.WithConn(cc),
-					session.WithDeleteTimeout(cfg.SessionDeleteTimeout()),
-					session.WithTrace(cfg.Trace()),
-				)
-				if err != nil {
-					return nil, xerrors.WithStackTrace(err)
-				}
-
-				s.laztTx = cfg.LazyTx()
-
-				return s, nil
-			}),
+		cc:     cc,
+		client: Ydb_Query_V1.NewQueryServiceClient(cc),
+		operations: Ydb_Operation_V1.NewOperationServiceClient(
+			conn.WithContextModifier(cc, conn.WithoutWrapping),
 		),
+		done: make(chan struct{}),
+	}
+
+	client.pool = pool.New(ctx,
+		pool.WithLimit[*Session, Session](cfg.PoolLimit()),
+		pool.WithMinSize[*Session, Session](cfg.PoolMinSize()),
+		pool.WithStatsCallback[*Session, Session](cfg.PoolStatsCallback()),
+		pool.WithItemUsageLimit[*Session, Session](cfg.PoolSessionUsageLimit()),
+		pool.WithTrace[*Session, Session](poolTrace(cfg.Trace())),
+		pool.WithCreateItemTimeout[*Session, Session](cfg.SessionCreateTimeout()),
+		pool.WithCloseItemTimeout[*Session, Session](cfg.SessionDeleteTimeout()),
+		pool.WithIdleTimeToLive[*Session, Session](cfg.SessionIdleTimeToLive()),
+		pool.WithCreateItemFunc(client.createSession),
+	)
+
+	return client
+}
+
+func (c *Client) createSession(ctx context.Context) (_ *Session, err error) {
+	var (
+		createCtx    context.Context
+		cancelCreate context.CancelFunc
+	)
+	if d := c.config.SessionCreateTimeout(); d > 0 {
+		createCtx, cancelCreate = xcontext.WithTimeout(ctx, d)
+	} else {
+		createCtx, cancelCreate = xcontext.WithCancel(ctx)
+	}
+	defer cancelCreate()
+
+	s, err := createSession(createCtx, c.client,
+		session.WithConn(c.cc),
+		session.WithDeleteTimeout(c.config.SessionDeleteTimeout()),
+		session.WithTrace(c.config.Trace()),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	s.laztTx = c.config.LazyTx()
+
+	return s, nil
+}
+
+// CreateSession creates a standalone session which is not owned by the client session pool.
+// The caller is responsible for closing the session.
+func CreateSession(ctx context.Context, c *Client) (*Session, error) {
+	ctx, cancel := xcontext.WithDone(ctx, c.done)
+	defer cancel()
+
+	s, err := c.createSession(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
 	}
+
+	return s, nil
 }
 
 func poolTrace(t *trace.Query) *pool.Trace {
@@ -391,6 +391,48 @@ func TestClient(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, 10, counter)
 		})
+		t.Run("Hooks", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := NewMockQueryServiceClient(ctrl)
+			client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+				TxMeta: &Ydb_Query.TransactionMeta{Id: "456"},
+			}, nil).Times(2)
+			client.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.RollbackTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil).Times(1)
+			client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil).Times(1)
+			var (
+				attempt    = 0
+				committed  []int
+				rolledBack []int
+				testErr    = xerrors.Retryable(errors.New("test"))
+			)
+			err := doTx(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+				return newTestSessionWithClient("123", client, false), nil
+			}), func(ctx context.Context, tx query.TxActor) error {
+				attempt++
+				a := attempt
+				hooks := tx.(query.TxHooks) //nolint:forcetypeassert
+				hooks.OnCommit(func() {
+					committed = append(committed, a)
+				})
+				hooks.OnRollback(func(err error) {
+					require.ErrorIs(t, err, testErr)
+					rolledBack = append(rolledBack, a)
+				})
+				if attempt < 2 {
+					return testErr
+				}
+
+				return nil
+			}, tx.NewSettings(tx.WithDefaultTxMode()))
+			require.NoError(t, err)
+			require.Equal(t, []int{2}, committed)
+			require.Equal(t, []int{1}, rolledBack)
+		})
 		t.Run("TxLeak", func(t *testing.T) {
 			t.Run("OnExec", func(t *testing.T) {
 				t.Run("WithoutCommit", func(t *testing.T) {
@@ -844,6 +886,45 @@ func TestClient(t *testing.T) {
 			require.NoError(t, err)
 		})
 	})
+	t.Run("Explain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		// caller options with spare capacity must not be changed
+		opts := make([]options.Execute, 0, 10)
+		opts = append(opts, options.WithSyntax(options.SyntaxYQL))
+		explanation, err := clientExplain(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+			stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+			stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+				Status: Ydb.StatusIds_SUCCESS,
+				ExecStats: &Ydb_TableStats.QueryStats{
+					QueryAst: "(return)",
+					QueryPlan: `{"Plan":{"Node Type":"Query","Plans":[{"Node Type":"TableFullScan","PlanNodeId":1,` +
+						`"Operators":[{"Name":"TableFullScan","Table":"series","E-Rows":"10"}]}]},` +
+						`"tables":[{"name":"/local/series","reads":[{"type":"FullScan"}]}]}`,
+				},
+			}, nil)
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+			client := NewMockQueryServiceClient(ctrl)
+			client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+					Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+				) {
+					require.Equal(t, Ydb_Query.ExecMode_EXEC_MODE_EXPLAIN, in.GetExecMode())
+
+					return stream, nil
+				},
+			)
+
+			return newTestSessionWithClient("123", client, true), nil
+		}), "SELECT * FROM series", opts...)
+		require.NoError(t, err)
+		require.Nil(t, opts[:cap(opts)][1])
+		require.Equal(t, "(return)", explanation.AST)
+		require.Equal(t, "Query", explanation.Plan.Root.Type)
+		fullScans := explanation.Plan.FullScans()
+		require.Len(t, fullScans, 1)
+		require.Equal(t, "series", fullScans[0].Table)
+		require.Equal(t, 10.0, fullScans[0].EstimatedRows.Value)
+	})
 	t.Run("Query", func(t *testing.T) {
 		t.Run("HappyWay", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -18,6 +18,7 @@ type Config struct {
 	config.Common
 
 	poolLimit             int
+	poolMinSize           int
 	poolSessionUsageLimit uint64
 
 	sessionCreateTimeout   time.Duration
@@ -26,6 +27,9 @@ type Config struct {
 
 	lazyTx bool
 
+	poolStatsInterval time.Duration
+	onPoolStats       func(stats pool.Stats)
+
 	trace *trace.Query
 }
 
@@ -61,6 +65,17 @@ func (c *Config) PoolLimit() int {
 	return c.poolLimit
 }
 
+// PoolMinSize is a minimum count of sessions which pool prefills in background
+// and keeps opened. Zero PoolMinSize means that sessions created on demand only
+func (c *Config) PoolMinSize() int {
+	return c.poolMinSize
+}
+
+// PoolStatsCallback returns interval and callback for periodic snapshots of session pool stats
+func (c *Config) PoolStatsCallback() (interval time.Duration, onStats func(stats pool.Stats)) {
+	return c.poolStatsInterval, c.onPoolStats
+}
+
 func (c *Config) PoolSessionUsageLimit() uint64 {
 	return c.poolSessionUsageLimit
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -34,6 +35,26 @@ func WithPoolLimit(size int) Option {
 	}
 }
 
+// WithPoolMinSize defines minimum count of sessions which pool prefills in background
+// after creating client and refills when sessions closed. If size is greater than pool
+// limit then pool limit is used as minimum size
+func WithPoolMinSize(size int) Option {
+	return func(c *Config) {
+		if size > 0 {
+			c.poolMinSize = size
+		}
+	}
+}
+
+// WithPoolStatsCallback defines callback which called with snapshot of session pool stats
+// periodically with interval
+func WithPoolStatsCallback(interval time.Duration, onStats func(stats pool.Stats)) Option {
+	return func(c *Config) {
+		c.poolStatsInterval = interval
+		c.onPoolStats = onStats
+	}
+}
+
 func WithPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	return func(c *Config) {
 		c.poolSessionUsageLimit = sessionUsageLimit
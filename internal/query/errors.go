@@ -8,6 +8,7 @@ import (
 
 var (
 	ErrTransactionRollingBack  = xerrors.Wrap(errors.New("ydb: the transaction is rolling back"))
+	errClosedClient            = xerrors.Wrap(errors.New("query client closed early"))
 	errWrongNextResultSetIndex = errors.New("wrong result set index")
 	errWrongResultSetIndex     = errors.New("critical violation of the logic - wrong result set index")
 	errMoreThanOneRow          = errors.New("unexpected more than one row in result set")
@@ -30,6 +30,7 @@ type executeSettings interface {
 	Params() *params.Parameters
 	CallOptions() []grpc.CallOption
 	RetryOpts() []retry.Option
+	Err() error
 }
 
 type executeScriptConfig interface {
@@ -101,6 +102,10 @@ func execute(
 ) (
 	_ *streamResult, finalErr error,
 ) {
+	if err := settings.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	a := allocator.New()
 	defer a.Free()
 
@@ -0,0 +1,328 @@
+package explain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errEmptyPlan = errors.New("empty query plan")
+
+type (
+	// Explanation is a result of query explain
+	Explanation struct {
+		// AST is a query abstract syntax tree
+		AST string
+		// Plan is a parsed query plan
+		Plan *Plan
+		// RawPlan is a query plan in JSON format as returned by server
+		RawPlan string
+	}
+
+	// Plan is a tree of query plan nodes with summary of touched tables
+	Plan struct {
+		// Root is a root node of query plan tree
+		Root *Node
+		// Tables contains summary of reads and writes for each table touched by query
+		Tables []Table
+	}
+
+	// Node is a node of query plan tree (stage, connection or result set)
+	Node struct {
+		ID           int
+		Type         string
+		PlanNodeType string
+		Operators    []Operator
+		Tables       []string
+		Children     []*Node
+	}
+
+	// Operator is an operator of query plan node (TableFullScan, TableRangeScan, Filter, Limit, etc.)
+	Operator struct {
+		Name          string
+		Table         string
+		ReadColumns   []string
+		ReadRanges    []string
+		EstimatedRows Estimate
+		EstimatedCost Estimate
+		EstimatedSize Estimate
+		// Properties contains other operator properties (such as Limit, Predicate, etc.) as is
+		Properties map[string]interface{}
+	}
+
+	// Estimate is an estimation of query optimizer. Valid is false if optimizer has no estimation
+	Estimate struct {
+		Value float64
+		Valid bool
+	}
+
+	// Table is a summary of accesses to the table
+	Table struct {
+		Name   string
+		Reads  []TableAccess
+		Writes []TableAccess
+	}
+
+	// TableAccess describes single read or write access to the table
+	TableAccess struct {
+		Type    string
+		Columns []string
+		ScanBy  []string
+		Limit   string
+		Reverse bool
+	}
+)
+
+func (e Estimate) String() string {
+	if !e.Valid {
+		return "?"
+	}
+
+	return strconv.FormatFloat(e.Value, 'f', -1, 64)
+}
+
+// IsFullScan checks operator reads full table
+func (op *Operator) IsFullScan() bool {
+	return strings.Contains(op.Name, "FullScan")
+}
+
+// Walk visits nodes of plan tree in depth-first order while visit returns true
+func (p *Plan) Walk(visit func(n *Node) bool) {
+	if p == nil || p.Root == nil {
+		return
+	}
+	p.Root.walk(visit)
+}
+
+func (n *Node) walk(visit func(n *Node) bool) bool {
+	if !visit(n) {
+		return false
+	}
+	for _, child := range n.Children {
+		if !child.walk(visit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Operators returns all operators of plan in depth-first order
+func (p *Plan) Operators() (operators []Operator) {
+	p.Walk(func(n *Node) bool {
+		operators = append(operators, n.Operators...)
+
+		return true
+	})
+
+	return operators
+}
+
+// FullScans returns full scan operators of plan. Estimated rows of operators
+// can be used for check full scans of big tables
+func (p *Plan) FullScans() (operators []Operator) {
+	for _, op := range p.Operators() {
+		if op.IsFullScan() {
+			operators = append(operators, op)
+		}
+	}
+
+	return operators
+}
+
+type (
+	jsonPlan struct {
+		Plan    *jsonNode   `json:"Plan"`
+		Tables  []jsonTable `json:"tables"`
+		Queries []jsonPlan  `json:"queries"`
+	}
+	jsonNode struct {
+		ID           int                      `json:"PlanNodeId"`
+		Type         string                   `json:"Node Type"`
+		PlanNodeType string                   `json:"PlanNodeType"`
+		Operators    []map[string]interface{} `json:"Operators"`
+		Tables       []string                 `json:"Tables"`
+		Plans        []*jsonNode              `json:"Plans"`
+	}
+	jsonTable struct {
+		Name   string            `json:"name"`
+		Reads  []jsonTableAccess `json:"reads"`
+		Writes []jsonTableAccess `json:"writes"`
+	}
+	jsonTableAccess struct {
+		Type    string      `json:"type"`
+		Columns []string    `json:"columns"`
+		ScanBy  []string    `json:"scan_by"`
+		Limit   interface{} `json:"limit"`
+		Reverse bool        `json:"reverse"`
+	}
+)
+
+// Parse parses query plan in JSON format as returned by YDB server.
+// Both query plan format (with single plan) and script plan format (with list of
+// queries) are supported. Plans of script queries becomes children of synthetic
+// root node with type "Script"
+func Parse(plan string) (*Plan, error) {
+	if strings.TrimSpace(plan) == "" {
+		return nil, xerrors.WithStackTrace(errEmptyPlan)
+	}
+
+	var p jsonPlan
+	if err := json.Unmarshal([]byte(plan), &p); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("parse query plan failed: %w", err))
+	}
+
+	if len(p.Queries) > 0 {
+		root := &Node{
+			Type: "Script",
+		}
+		tables := p.Tables
+		for i := range p.Queries {
+			if p.Queries[i].Plan != nil {
+				root.Children = append(root.Children, p.Queries[i].Plan.toNode())
+			}
+			tables = append(tables, p.Queries[i].Tables...)
+		}
+
+		return &Plan{
+			Root:   root,
+			Tables: toTables(tables),
+		}, nil
+	}
+
+	if p.Plan == nil {
+		return nil, xerrors.WithStackTrace(errEmptyPlan)
+	}
+
+	return &Plan{
+		Root:   p.Plan.toNode(),
+		Tables: toTables(p.Tables),
+	}, nil
+}
+
+func (n *jsonNode) toNode() *Node {
+	node := &Node{
+		ID:           n.ID,
+		Type:         n.Type,
+		PlanNodeType: n.PlanNodeType,
+		Tables:       n.Tables,
+		Operators:    make([]Operator, 0, len(n.Operators)),
+		Children:     make([]*Node, 0, len(n.Plans)),
+	}
+	for _, op := range n.Operators {
+		node.Operators = append(node.Operators, toOperator(op))
+	}
+	for _, child := range n.Plans {
+		node.Children = append(node.Children, child.toNode())
+	}
+
+	return node
+}
+
+func toOperator(properties map[string]interface{}) Operator {
+	op := Operator{
+		Properties: make(map[string]interface{}, len(properties)),
+	}
+	for k, v := range properties {
+		switch k {
+		case "Name":
+			op.Name = toString(v)
+		case "Table":
+			op.Table = toString(v)
+		case "ReadColumns":
+			op.ReadColumns = toStrings(v)
+		case "ReadRanges", "ReadRange":
+			op.ReadRanges = append(op.ReadRanges, toStrings(v)...)
+		case "E-Rows":
+			op.EstimatedRows = toEstimate(v)
+		case "E-Cost":
+			op.EstimatedCost = toEstimate(v)
+		case "E-Size":
+			op.EstimatedSize = toEstimate(v)
+		case "Inputs":
+			// internal links between operators of node
+		default:
+			op.Properties[k] = v
+		}
+	}
+
+	return op
+}
+
+func toTables(tables []jsonTable) []Table {
+	result := make([]Table, 0, len(tables))
+	for _, t := range tables {
+		result = append(result, Table{
+			Name:   t.Name,
+			Reads:  toTableAccesses(t.Reads),
+			Writes: toTableAccesses(t.Writes),
+		})
+	}
+
+	return result
+}
+
+func toTableAccesses(accesses []jsonTableAccess) []TableAccess {
+	if len(accesses) == 0 {
+		return nil
+	}
+	result := make([]TableAccess, 0, len(accesses))
+	for _, a := range accesses {
+		result = append(result, TableAccess{
+			Type:    a.Type,
+			Columns: a.Columns,
+			ScanBy:  a.ScanBy,
+			Limit:   toString(a.Limit),
+			Reverse: a.Reverse,
+		})
+	}
+
+	return result
+}
+
+func toString(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vv
+	default:
+		return fmt.Sprint(vv)
+	}
+}
+
+func toStrings(v interface{}) []string {
+	switch vv := v.(type) {
+	case []interface{}:
+		result := make([]string, 0, len(vv))
+		for _, item := range vv {
+			result = append(result, toString(item))
+		}
+
+		return result
+	case nil:
+		return nil
+	default:
+		return []string{toString(vv)}
+	}
+}
+
+func toEstimate(v interface{}) Estimate {
+	switch vv := v.(type) {
+	case float64:
+		return Estimate{Value: vv, Valid: true}
+	case string:
+		f, err := strconv.ParseFloat(vv, 64)
+		if err != nil {
+			return Estimate{}
+		}
+
+		return Estimate{Value: f, Valid: true}
+	default:
+		return Estimate{}
+	}
+}
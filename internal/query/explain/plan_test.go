@@ -0,0 +1,188 @@
+package explain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const queryPlan = `{
+  "Plan": {
+    "Node Type": "Query",
+    "PlanNodeType": "Query",
+    "Plans": [
+      {
+        "Node Type": "ResultSet",
+        "PlanNodeId": 3,
+        "PlanNodeType": "ResultSet",
+        "Plans": [
+          {
+            "Node Type": "Limit-TableFullScan",
+            "PlanNodeId": 1,
+            "Operators": [
+              {
+                "Inputs": [{"InternalOperatorId": 1}],
+                "Limit": "1001",
+                "Name": "Limit"
+              },
+              {
+                "Inputs": [],
+                "Name": "TableFullScan",
+                "ReadColumns": ["id", "title"],
+                "ReadRanges": ["series_id (-∞, +∞)"],
+                "Table": "series",
+                "E-Rows": "10",
+                "E-Cost": 20,
+                "E-Size": "No estimate"
+              }
+            ],
+            "Tables": ["series"]
+          }
+        ]
+      }
+    ]
+  },
+  "meta": {"type": "query", "version": "0.2"},
+  "tables": [
+    {
+      "name": "/local/series",
+      "reads": [
+        {
+          "columns": ["id", "title"],
+          "scan_by": ["series_id (-∞, +∞)"],
+          "type": "FullScan",
+          "limit": "1001"
+        }
+      ]
+    }
+  ]
+}`
+
+const scriptPlan = `{
+  "meta": {"type": "script", "version": "0.2"},
+  "queries": [
+    {
+      "Plan": {
+        "Node Type": "Query",
+        "PlanNodeType": "Query",
+        "Plans": [
+          {
+            "Node Type": "Effect",
+            "PlanNodeId": 2,
+            "Operators": [
+              {"Name": "Upsert", "Table": "episodes"}
+            ],
+            "Tables": ["episodes"]
+          }
+        ]
+      },
+      "tables": [
+        {"name": "/local/episodes", "writes": [{"type": "MultiUpsert", "columns": ["id"]}]}
+      ]
+    },
+    {
+      "Plan": {
+        "Node Type": "Query",
+        "PlanNodeType": "Query",
+        "Plans": [
+          {
+            "Node Type": "TableRangeScan",
+            "PlanNodeId": 1,
+            "Operators": [
+              {"Name": "TableRangeScan", "Table": "seasons", "ReadRange": ["id (1, 5)"], "E-Rows": 4}
+            ]
+          }
+        ]
+      },
+      "tables": [
+        {"name": "/local/seasons", "reads": [{"type": "Scan", "scan_by": ["id (1, 5)"], "reverse": true}]}
+      ]
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	t.Run("Query", func(t *testing.T) {
+		plan, err := Parse(queryPlan)
+		require.NoError(t, err)
+		require.Equal(t, "Query", plan.Root.Type)
+		require.Len(t, plan.Root.Children, 1)
+		require.Equal(t, 3, plan.Root.Children[0].ID)
+		require.Equal(t, []Operator{
+			{
+				Name: "Limit",
+				Properties: map[string]interface{}{
+					"Limit": "1001",
+				},
+			},
+			{
+				Name:          "TableFullScan",
+				Table:         "series",
+				ReadColumns:   []string{"id", "title"},
+				ReadRanges:    []string{"series_id (-∞, +∞)"},
+				EstimatedRows: Estimate{Value: 10, Valid: true},
+				EstimatedCost: Estimate{Value: 20, Valid: true},
+				EstimatedSize: Estimate{},
+				Properties:    map[string]interface{}{},
+			},
+		}, plan.Operators())
+		require.Equal(t, []Table{
+			{
+				Name: "/local/series",
+				Reads: []TableAccess{
+					{
+						Type:    "FullScan",
+						Columns: []string{"id", "title"},
+						ScanBy:  []string{"series_id (-∞, +∞)"},
+						Limit:   "1001",
+					},
+				},
+			},
+		}, plan.Tables)
+		fullScans := plan.FullScans()
+		require.Len(t, fullScans, 1)
+		require.Equal(t, "series", fullScans[0].Table)
+		require.Equal(t, 10.0, fullScans[0].EstimatedRows.Value)
+		require.Equal(t, ""+
+			"Query\n"+
+			"└─ ResultSet #3\n"+
+			"   └─ Limit-TableFullScan #1 tables=[series]\n"+
+			"         · Limit Limit=1001\n"+
+			"         · TableFullScan table=series rows=10 cost=20 columns=[id,title] ranges=[series_id (-∞, +∞)]\n"+
+			"Tables:\n"+
+			"  /local/series read FullScan columns=[id,title] scan_by=[series_id (-∞, +∞)] limit=1001\n",
+			plan.String(),
+		)
+	})
+	t.Run("Script", func(t *testing.T) {
+		plan, err := Parse(scriptPlan)
+		require.NoError(t, err)
+		require.Equal(t, "Script", plan.Root.Type)
+		require.Len(t, plan.Root.Children, 2)
+		require.Empty(t, plan.FullScans())
+		require.Len(t, plan.Tables, 2)
+		require.Equal(t, ""+
+			"Script\n"+
+			"├─ Query\n"+
+			"│  └─ Effect #2 tables=[episodes]\n"+
+			"│        · Upsert table=episodes\n"+
+			"└─ Query\n"+
+			"   └─ TableRangeScan #1\n"+
+			"         · TableRangeScan table=seasons rows=4 ranges=[id (1, 5)]\n"+
+			"Tables:\n"+
+			"  /local/episodes write MultiUpsert columns=[id]\n"+
+			"  /local/seasons read Scan scan_by=[id (1, 5)] reverse\n",
+			plan.String(),
+		)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		_, err := Parse("")
+		require.ErrorIs(t, err, errEmptyPlan)
+		_, err = Parse("{}")
+		require.ErrorIs(t, err, errEmptyPlan)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := Parse("{")
+		require.Error(t, err)
+	})
+}
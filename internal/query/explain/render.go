@@ -0,0 +1,146 @@
+package explain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// String renders query plan as text tree. Example:
+//
+//	Query
+//	└─ ResultSet #3
+//	   └─ Limit-TableFullScan #1 tables=[series]
+//	         · Limit Limit=1001
+//	         · TableFullScan table=series rows=10 cost=20 columns=[id,title]
+//	Tables:
+//	  /local/series read FullScan columns=[id,title]
+func (p *Plan) String() string {
+	if p == nil || p.Root == nil {
+		return ""
+	}
+
+	var buffer strings.Builder
+
+	renderNode(&buffer, p.Root, "", "", "")
+
+	if len(p.Tables) > 0 {
+		buffer.WriteString("Tables:\n")
+		for i := range p.Tables {
+			for _, access := range p.Tables[i].Reads {
+				renderTableAccess(&buffer, p.Tables[i].Name, "read", &access)
+			}
+			for _, access := range p.Tables[i].Writes {
+				renderTableAccess(&buffer, p.Tables[i].Name, "write", &access)
+			}
+		}
+	}
+
+	return buffer.String()
+}
+
+func renderNode(buffer *strings.Builder, n *Node, prefix, connector, childPrefix string) {
+	buffer.WriteString(prefix)
+	buffer.WriteString(connector)
+	buffer.WriteString(n.title())
+	buffer.WriteByte('\n')
+
+	operatorPrefix := prefix + childPrefix
+	if len(n.Children) > 0 {
+		operatorPrefix += "│  "
+	} else {
+		operatorPrefix += "   "
+	}
+	for i := range n.Operators {
+		buffer.WriteString(operatorPrefix)
+		buffer.WriteString("· ")
+		buffer.WriteString(n.Operators[i].String())
+		buffer.WriteByte('\n')
+	}
+
+	for i, child := range n.Children {
+		if i == len(n.Children)-1 {
+			renderNode(buffer, child, prefix+childPrefix, "└─ ", "   ")
+		} else {
+			renderNode(buffer, child, prefix+childPrefix, "├─ ", "│  ")
+		}
+	}
+}
+
+func (n *Node) title() string {
+	title := n.Type
+	if title == "" {
+		title = n.PlanNodeType
+	}
+	if n.ID != 0 {
+		title += " #" + strconv.Itoa(n.ID)
+	}
+	if len(n.Tables) > 0 {
+		title += " tables=[" + strings.Join(n.Tables, ",") + "]"
+	}
+
+	return title
+}
+
+// String renders operator with main properties in single line
+func (op *Operator) String() string {
+	parts := []string{op.Name}
+	if op.Table != "" {
+		parts = append(parts, "table="+op.Table)
+	}
+	if op.EstimatedRows.Valid {
+		parts = append(parts, "rows="+op.EstimatedRows.String())
+	}
+	if op.EstimatedCost.Valid {
+		parts = append(parts, "cost="+op.EstimatedCost.String())
+	}
+	if len(op.ReadColumns) > 0 {
+		parts = append(parts, "columns=["+strings.Join(op.ReadColumns, ",")+"]")
+	}
+	if len(op.ReadRanges) > 0 {
+		parts = append(parts, "ranges=["+strings.Join(op.ReadRanges, ",")+"]")
+	}
+	keys := make([]string, 0, len(op.Properties))
+	for k := range op.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch v := op.Properties[k].(type) {
+		case string, float64, bool:
+			parts = append(parts, fmt.Sprintf("%s=%v", k, v))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func renderTableAccess(buffer *strings.Builder, table, kind string, access *TableAccess) {
+	buffer.WriteString("  ")
+	buffer.WriteString(table)
+	buffer.WriteByte(' ')
+	buffer.WriteString(kind)
+	if access.Type != "" {
+		buffer.WriteByte(' ')
+		buffer.WriteString(access.Type)
+	}
+	if len(access.Columns) > 0 {
+		buffer.WriteString(" columns=[")
+		buffer.WriteString(strings.Join(access.Columns, ","))
+		buffer.WriteByte(']')
+	}
+	if len(access.ScanBy) > 0 {
+		buffer.WriteString(" scan_by=[")
+		buffer.WriteString(strings.Join(access.ScanBy, ","))
+		buffer.WriteByte(']')
+	}
+	if access.Limit != "" {
+		buffer.WriteString(" limit=")
+		buffer.WriteString(access.Limit)
+	}
+	if access.Reverse {
+		buffer.WriteString(" reverse")
+	}
+	buffer.WriteByte('\n')
+}
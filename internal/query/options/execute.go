@@ -7,6 +7,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 )
 
@@ -14,6 +15,7 @@ var (
 	_ Execute = callOptionsOption(nil)
 	_ Execute = (*txCommitOption)(nil)
 	_ Execute = parametersOption{}
+	_ Execute = structParametersOption{}
 	_ Execute = (*txControlOption)(nil)
 	_ Execute = syntaxOption(0)
 	_ Execute = statsModeOption{}
@@ -35,6 +37,7 @@ type (
 		callOptions   []grpc.CallOption
 		txControl     *tx.Control
 		retryOptions  []retry.Option
+		err           error
 	}
 
 	// Execute is an interface for execute method options
@@ -47,12 +50,16 @@ type (
 	}
 
 	// execute options
-	callOptionsOption []grpc.CallOption
-	txCommitOption    struct{}
-	parametersOption  params.Parameters
-	txControlOption   tx.Control
-	syntaxOption      = Syntax
-	statsModeOption   struct {
+	callOptionsOption      []grpc.CallOption
+	txCommitOption         struct{}
+	parametersOption       params.Parameters
+	structParametersOption struct {
+		v    interface{}
+		opts []params.FromStructOption
+	}
+	txControlOption tx.Control
+	syntaxOption    = Syntax
+	statsModeOption struct {
 		mode     StatsMode
 		callback func(stats.QueryStats)
 	}
@@ -90,6 +97,16 @@ func (params parametersOption) applyExecuteOption(s *executeSettings) {
 	s.params = append(s.params, params...)
 }
 
+func (opt structParametersOption) applyExecuteOption(s *executeSettings) {
+	parameters, err := params.FromStruct(opt.v, opt.opts...)
+	if err != nil {
+		s.err = xerrors.WithStackTrace(err)
+
+		return
+	}
+	s.params = append(s.params, *parameters...)
+}
+
 func (opts callOptionsOption) applyExecuteOption(s *executeSettings) {
 	s.callOptions = append(s.callOptions, opts...)
 }
@@ -169,6 +186,18 @@ func WithParameters(parameters *params.Parameters) parametersOption {
 	return parametersOption(*parameters)
 }
 
+func WithStructParameters(v interface{}, opts ...params.FromStructOption) structParametersOption {
+	return structParametersOption{
+		v:    v,
+		opts: opts,
+	}
+}
+
+// Err returns error of applying execute options (such as failed conversion of struct parameters)
+func (s *executeSettings) Err() error {
+	return s.err
+}
+
 var (
 	_ Execute = ExecMode(0)
 	_ Execute = StatsMode(0)
@@ -0,0 +1,150 @@
+package scanner
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	typeSQLScanner        = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	typeJSONUnmarshaler   = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	typeTextUnmarshaler   = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	typeBinaryUnmarshaler = reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()
+	unmarshalerInterfaces = []reflect.Type{
+		typeSQLScanner,
+		typeJSONUnmarshaler,
+		typeTextUnmarshaler,
+		typeBinaryUnmarshaler,
+	}
+)
+
+func isUnmarshaler(t reflect.Type) bool {
+	for _, i := range unmarshalerInterfaces {
+		if t.Implements(i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// castTo extends value.CastTo with destinations which implements sql.Scanner,
+// json.Unmarshaler, encoding.TextUnmarshaler or encoding.BinaryUnmarshaler.
+// Optional values can be scanned into pointer to such destinations (**T): NULL
+// sets the pointer to nil.
+// Container values (List, Set, Dict, Tuple, Struct, Variant) are decoded recursively
+// into slices, arrays, maps and structs
+func castTo(v value.Value, dst interface{}, settings *scanStructSettings) error {
+	if dst == nil {
+		return value.CastTo(v, dst)
+	}
+	if _, has := dst.(*value.Value); has {
+		return value.CastTo(v, dst)
+	}
+
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() {
+		return value.CastTo(v, dst)
+	}
+
+	if isUnmarshaler(ptr.Type()) {
+		return unmarshal(v, ptr)
+	}
+
+	if inner := ptr.Elem(); inner.Kind() == reflect.Pointer && isUnmarshaler(inner.Type()) {
+		if value.Unwrap(v) == nil {
+			inner.SetZero()
+
+			return nil
+		}
+		newValue := reflect.New(inner.Type().Elem())
+		if err := unmarshal(v, newValue); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		inner.Set(newValue)
+
+		return nil
+	}
+
+	if isContainer(v.Type()) {
+		return castContainer(v, ptr, settings)
+	}
+
+	return value.CastTo(v, dst)
+}
+
+// unmarshal applies value to destination pointer which implements one of the unmarshaler interfaces.
+// sql.Scanner has the highest priority and receives the same values as table service scanner.
+// Destinations which natively supported by value.CastTo (such as time.Time) are casted as usual.
+// Other interfaces are chosen by value type: json.Unmarshaler for JSON values,
+// encoding.BinaryUnmarshaler for binary values and encoding.TextUnmarshaler for the rest
+func unmarshal(v value.Value, ptr reflect.Value) error {
+	if scanner, ok := ptr.Interface().(sql.Scanner); ok {
+		src, err := value.Any(v)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if err = scanner.Scan(src); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("sql.Scanner error: %w", err))
+		}
+
+		return nil
+	}
+
+	if value.Unwrap(v) == nil {
+		ptr.Elem().SetZero()
+
+		return nil
+	}
+
+	if err := value.CastTo(v, ptr.Interface()); err == nil {
+		return nil
+	} else if !xerrors.Is(err, value.ErrCannotCast) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	v = value.Unwrap(v)
+
+	var bytes []byte
+	if err := value.CastTo(v, &bytes); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	switch v.Type() {
+	case types.JSON, types.JSONDocument:
+		if unmarshaler, ok := ptr.Interface().(json.Unmarshaler); ok {
+			if err := unmarshaler.UnmarshalJSON(bytes); err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("json.Unmarshaler error: %w", err))
+			}
+
+			return nil
+		}
+	case types.Bytes, types.YSON, types.UUID:
+		if unmarshaler, ok := ptr.Interface().(encoding.BinaryUnmarshaler); ok {
+			if err := unmarshaler.UnmarshalBinary(bytes); err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("encoding.BinaryUnmarshaler error: %w", err))
+			}
+
+			return nil
+		}
+	}
+
+	if unmarshaler, ok := ptr.Interface().(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText(bytes); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("encoding.TextUnmarshaler error: %w", err))
+		}
+
+		return nil
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("%w '%s' to '%s' destination",
+		value.ErrCannotCast, v.Type().Yql(), ptr.Type().String(),
+	))
+}
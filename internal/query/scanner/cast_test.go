@@ -0,0 +1,290 @@
+package scanner
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type sqlScanner struct {
+	src interface{}
+}
+
+func (s *sqlScanner) Scan(src interface{}) error {
+	s.src = src
+
+	return nil
+}
+
+type jsonUnmarshaler struct {
+	bytes string
+}
+
+func (u *jsonUnmarshaler) UnmarshalJSON(bytes []byte) error {
+	u.bytes = string(bytes)
+
+	return nil
+}
+
+type textUnmarshaler struct {
+	text string
+}
+
+func (u *textUnmarshaler) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty text")
+	}
+	u.text = strings.ToUpper(string(text))
+
+	return nil
+}
+
+type binaryUnmarshaler struct {
+	data []byte
+}
+
+func (u *binaryUnmarshaler) UnmarshalBinary(data []byte) error {
+	u.data = append([]byte{}, data...)
+
+	return nil
+}
+
+// textBinaryUnmarshaler implements both of interfaces for check priority by value type
+type textBinaryUnmarshaler struct {
+	how string
+}
+
+func (u *textBinaryUnmarshaler) UnmarshalText([]byte) error {
+	u.how = "text"
+
+	return nil
+}
+
+func (u *textBinaryUnmarshaler) UnmarshalBinary([]byte) error {
+	u.how = "binary"
+
+	return nil
+}
+
+func TestCastTo(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		src  value.Value
+		dst  interface{}
+		exp  interface{}
+		err  bool
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.Int64Value(42),
+			dst:  &sqlScanner{},
+			exp:  &sqlScanner{src: int64(42)},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.TextValue("test")),
+			dst:  &sqlScanner{},
+			exp:  &sqlScanner{src: "test"},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.NullValue(types.Text),
+			dst:  &sqlScanner{src: "test"},
+			exp:  &sqlScanner{src: nil},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TzDatetimeValue("2024-01-02T03:04:05,UTC"),
+			dst:  &sqlScanner{},
+			exp:  &sqlScanner{src: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.JSONValue(`{"a":1}`),
+			dst:  &jsonUnmarshaler{},
+			exp:  &jsonUnmarshaler{bytes: `{"a":1}`},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.JSONDocumentValue(`{"a":1}`)),
+			dst:  &jsonUnmarshaler{},
+			exp:  &jsonUnmarshaler{bytes: `{"a":1}`},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TextValue(`{"a":1}`),
+			dst:  &jsonUnmarshaler{},
+			err:  true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TextValue("test"),
+			dst:  &textUnmarshaler{},
+			exp:  &textUnmarshaler{text: "TEST"},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.TextValue("test")),
+			dst:  &textUnmarshaler{},
+			exp:  &textUnmarshaler{text: "TEST"},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.NullValue(types.Text),
+			dst:  &textUnmarshaler{text: "TEST"},
+			exp:  &textUnmarshaler{},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.Int32Value(42),
+			dst:  &textUnmarshaler{},
+			exp:  &textUnmarshaler{text: "42"},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.TextValue("test")),
+			dst:  &textUnmarshaler{},
+			err:  true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.BytesValue([]byte{1, 2, 3}),
+			dst:  &binaryUnmarshaler{},
+			exp:  &binaryUnmarshaler{data: []byte{1, 2, 3}},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.BytesValue([]byte{1, 2, 3}),
+			dst:  &textBinaryUnmarshaler{},
+			exp:  &textBinaryUnmarshaler{how: "binary"},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TextValue("test"),
+			dst:  &textBinaryUnmarshaler{},
+			exp:  &textBinaryUnmarshaler{how: "text"},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.TextValue("test")),
+			dst:  func(v *textUnmarshaler) **textUnmarshaler { return &v }(nil),
+			exp:  func(v *textUnmarshaler) **textUnmarshaler { return &v }(&textUnmarshaler{text: "TEST"}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.NullValue(types.Text),
+			dst:  func(v *textUnmarshaler) **textUnmarshaler { return &v }(&textUnmarshaler{text: "TEST"}),
+			exp:  func(v *textUnmarshaler) **textUnmarshaler { return &v }(nil),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.NullValue(types.Int64),
+			dst:  func(v *sqlScanner) **sqlScanner { return &v }(&sqlScanner{}),
+			exp:  func(v *sqlScanner) **sqlScanner { return &v }(nil),
+		},
+		{
+			// time.Time implements encoding.TextUnmarshaler, but scanned natively
+			name: xtest.CurrentFileLine(),
+			src:  value.TimestampValueFromTime(time.Unix(1, 0)),
+			dst:  func(v time.Time) *time.Time { return &v }(time.Time{}),
+			exp:  func(v time.Time) *time.Time { return &v }(time.Unix(1, 0)),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.TimestampValueFromTime(time.Unix(1, 0))),
+			dst:  func(v *time.Time) **time.Time { return &v }(nil),
+			exp: func(v time.Time) **time.Time {
+				vv := &v
+
+				return &vv
+			}(time.Unix(1, 0)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := defaultScanStructSettings()
+			err := castTo(tt.src, tt.dst, &settings)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.exp, tt.dst)
+			}
+		})
+	}
+}
+
+func TestScanStructWithUnmarshalers(t *testing.T) {
+	scanner := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_TypeId{
+						TypeId: Ydb.Type_UINT64,
+					},
+				},
+			},
+			{
+				Name: "doc",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_OptionalType{
+						OptionalType: &Ydb.OptionalType{
+							Item: &Ydb.Type{
+								Type: &Ydb.Type_TypeId{
+									TypeId: Ydb.Type_JSON,
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "name",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_OptionalType{
+						OptionalType: &Ydb.OptionalType{
+							Item: &Ydb.Type{
+								Type: &Ydb.Type_TypeId{
+									TypeId: Ydb.Type_UTF8,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		[]*Ydb.Value{
+			{
+				Value: &Ydb.Value_Uint64Value{
+					Uint64Value: 42,
+				},
+			},
+			{
+				Value: &Ydb.Value_TextValue{
+					TextValue: `{"a":1}`,
+				},
+			},
+			{
+				Value: &Ydb.Value_NullFlagValue{},
+			},
+		},
+	))
+	var dst struct {
+		ID   sqlScanner       `sql:"id"`
+		Doc  jsonUnmarshaler  `sql:"doc"`
+		Name *textUnmarshaler `sql:"name"`
+	}
+	dst.Name = &textUnmarshaler{text: "TEST"}
+	require.NoError(t, scanner.ScanStruct(&dst))
+	require.Equal(t, sqlScanner{src: uint64(42)}, dst.ID)
+	require.Equal(t, jsonUnmarshaler{bytes: `{"a":1}`}, dst.Doc)
+	require.Nil(t, dst.Name)
+}
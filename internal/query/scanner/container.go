@@ -0,0 +1,294 @@
+package scanner
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	listValue interface {
+		ListItems() []value.Value
+	}
+	setValue interface {
+		SetItems() []value.Value
+	}
+	tupleValue interface {
+		TupleItems() []value.Value
+	}
+	structValue interface {
+		StructFields() map[string]value.Value
+	}
+	dictValue interface {
+		DictValues() map[value.Value]value.Value
+	}
+	variantValue interface {
+		Variant() (name string, index uint32)
+		Value() value.Value
+	}
+)
+
+// isContainer checks type (with unwrapping optionals) is a container type
+func isContainer(t types.Type) bool {
+	for {
+		optional, isOptional := t.(types.Optional)
+		if !isOptional {
+			break
+		}
+		t = optional.InnerType()
+	}
+
+	switch t.(type) {
+	case *types.List, types.EmptyList, *types.Set, *types.Dict, types.EmptyDict, *types.Tuple, *types.Struct,
+		*types.VariantStruct, *types.VariantTuple:
+		return true
+	default:
+		return false
+	}
+}
+
+func errCannotCastContainer(v value.Value, dst reflect.Value) error {
+	return xerrors.WithStackTrace(fmt.Errorf("%w '%s' to '%s' destination",
+		value.ErrCannotCast, v.Type().Yql(), dst.Type().String(),
+	))
+}
+
+// castContainer decodes container value into destination by pointer ptr recursively:
+//   - List and Set into slice or array, Set also into map with bool or struct{} values
+//   - Tuple into slice, array or struct (items are mapped to exported struct fields by position)
+//   - Struct into struct (fields are mapped by tag like ScanStruct does) or map with string keys
+//   - Dict into map
+//   - Variant into destination for value of variant
+//
+// Optional containers can be scanned into pointers: NULL sets the pointer to nil
+func castContainer(v value.Value, ptr reflect.Value, settings *scanStructSettings) error {
+	dst := ptr.Elem()
+
+	if _, isOptional := v.Type().(types.Optional); isOptional {
+		v = value.Unwrap(v)
+		if v == nil {
+			dst.SetZero()
+
+			return nil
+		}
+	}
+
+	if dst.Kind() == reflect.Pointer {
+		newValue := reflect.New(dst.Type().Elem())
+		if err := castContainer(v, newValue, settings); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		dst.Set(newValue)
+
+		return nil
+	}
+
+	switch vv := v.(type) {
+	case listValue:
+		return castItems(vv.ListItems(), dst, settings)
+	case setValue:
+		if dst.Kind() == reflect.Map {
+			return castSetToMap(vv.SetItems(), dst, settings)
+		}
+
+		return castItems(vv.SetItems(), dst, settings)
+	case tupleValue:
+		if dst.Kind() == reflect.Struct {
+			return castTupleToStruct(vv.TupleItems(), dst, settings)
+		}
+		if dst.Kind() == reflect.Slice || dst.Kind() == reflect.Array {
+			return castItems(vv.TupleItems(), dst, settings)
+		}
+
+		// single item tuple can be casted into destination of item
+		return value.CastTo(v, ptr.Interface())
+	case structValue:
+		switch dst.Kind() {
+		case reflect.Struct:
+			return castStructToStruct(vv.StructFields(), dst, settings)
+		case reflect.Map:
+			return castStructToMap(vv.StructFields(), dst, settings)
+		default:
+			return errCannotCastContainer(v, dst)
+		}
+	case dictValue:
+		return castDictToMap(vv.DictValues(), dst, settings)
+	case variantValue:
+		return castTo(vv.Value(), ptr.Interface(), settings)
+	default:
+		return value.CastTo(v, ptr.Interface())
+	}
+}
+
+func castItems(items []value.Value, dst reflect.Value, settings *scanStructSettings) error {
+	switch dst.Kind() {
+	case reflect.Slice:
+		dst.Set(reflect.MakeSlice(dst.Type(), len(items), len(items)))
+	case reflect.Array:
+		if dst.Len() != len(items) {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: cannot cast %d items to '%s' destination",
+				value.ErrCannotCast, len(items), dst.Type().String(),
+			))
+		}
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot cast items to '%s' destination",
+			value.ErrCannotCast, dst.Type().String(),
+		))
+	}
+
+	for i := range items {
+		if err := castTo(items[i], dst.Index(i).Addr().Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("item #%d: %w", i, err))
+		}
+	}
+
+	return nil
+}
+
+func castSetToMap(items []value.Value, dst reflect.Value, settings *scanStructSettings) error {
+	t := dst.Type()
+	if t.Elem().Kind() != reflect.Bool && !(t.Elem().Kind() == reflect.Struct && t.Elem().NumField() == 0) {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot cast set to '%s' destination",
+			value.ErrCannotCast, t.String(),
+		))
+	}
+
+	dst.Set(reflect.MakeMapWithSize(t, len(items)))
+
+	present := reflect.New(t.Elem()).Elem()
+	if present.Kind() == reflect.Bool {
+		present.SetBool(true)
+	}
+
+	for i := range items {
+		key := reflect.New(t.Key())
+		if err := castTo(items[i], key.Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("set item '%s': %w", items[i].Yql(), err))
+		}
+		dst.SetMapIndex(key.Elem(), present)
+	}
+
+	return nil
+}
+
+func castDictToMap(values map[value.Value]value.Value, dst reflect.Value, settings *scanStructSettings) error {
+	if dst.Kind() != reflect.Map {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot cast dict to '%s' destination",
+			value.ErrCannotCast, dst.Type().String(),
+		))
+	}
+
+	t := dst.Type()
+	dst.Set(reflect.MakeMapWithSize(t, len(values)))
+
+	for k, v := range values {
+		key := reflect.New(t.Key())
+		if err := castTo(k, key.Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("dict key '%s': %w", k.Yql(), err))
+		}
+		elem := reflect.New(t.Elem())
+		if err := castTo(v, elem.Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("dict value for key '%s': %w", k.Yql(), err))
+		}
+		dst.SetMapIndex(key.Elem(), elem.Elem())
+	}
+
+	return nil
+}
+
+func castStructToMap(fields map[string]value.Value, dst reflect.Value, settings *scanStructSettings) error {
+	t := dst.Type()
+	if t.Key().Kind() != reflect.String {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot cast struct to '%s' destination",
+			value.ErrCannotCast, t.String(),
+		))
+	}
+
+	dst.Set(reflect.MakeMapWithSize(t, len(fields)))
+
+	for name, v := range fields {
+		elem := reflect.New(t.Elem())
+		if err := castTo(v, elem.Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("struct field '%s': %w", name, err))
+		}
+		dst.SetMapIndex(reflect.ValueOf(name).Convert(t.Key()), elem.Elem())
+	}
+
+	return nil
+}
+
+func castStructToStruct(fields map[string]value.Value, dst reflect.Value, settings *scanStructSettings) error {
+	t := dst.Type()
+	missingFields := make([]string, 0, t.NumField())
+	existingFields := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		name := fieldName(t.Field(i), settings.TagName)
+		if name == "-" {
+			continue
+		}
+
+		v, has := fields[name]
+		if !has {
+			missingFields = append(missingFields, name)
+
+			continue
+		}
+		if err := castTo(v, dst.Field(i).Addr().Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("struct field '%s': %w", name, err))
+		}
+		existingFields[name] = struct{}{}
+	}
+
+	if !settings.AllowMissingColumnsFromSelect && len(missingFields) > 0 {
+		return xerrors.WithStackTrace(
+			fmt.Errorf("%w: '%v'", errFieldsNotFoundInStructValue, strings.Join(missingFields, "','")),
+		)
+	}
+
+	if !settings.AllowMissingFieldsInStruct {
+		missingFields = missingFields[:0]
+		for name := range fields {
+			if _, has := existingFields[name]; !has {
+				missingFields = append(missingFields, name)
+			}
+		}
+		if len(missingFields) > 0 {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("%w: '%v'", ErrFieldsNotFoundInStruct, strings.Join(missingFields, "','")),
+			)
+		}
+	}
+
+	return nil
+}
+
+func castTupleToStruct(items []value.Value, dst reflect.Value, settings *scanStructSettings) error {
+	t := dst.Type()
+	fields := make([]int, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() && fieldName(t.Field(i), settings.TagName) != "-" {
+			fields = append(fields, i)
+		}
+	}
+
+	if len(fields) != len(items) {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot cast tuple with %d items to '%s' destination with %d fields",
+			value.ErrCannotCast, len(items), t.String(), len(fields),
+		))
+	}
+
+	for i := range items {
+		if err := castTo(items[i], dst.Field(fields[i]).Addr().Interface(), settings); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("tuple item #%d: %w", i, err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,482 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type nestedItem struct {
+	ID   uint64            `sql:"id"`
+	Name string            `sql:"name"`
+	Tags []string          `sql:"tags"`
+	Meta *textUnmarshaler  `sql:"meta"`
+	Attr map[string]int64  `sql:"attr"`
+	Skip string            `sql:"-"`
+	Deep *nestedItemDetail `sql:"deep"`
+}
+
+type nestedItemDetail struct {
+	Level int32 `sql:"level"`
+}
+
+func TestCastContainer(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		src      value.Value
+		dst      interface{}
+		exp      interface{}
+		settings func(settings *scanStructSettings)
+		err      error
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.Int64Value(1), value.Int64Value(2), value.Int64Value(3)),
+			dst:  func(v []int64) *[]int64 { return &v }(nil),
+			exp:  func(v []int64) *[]int64 { return &v }([]int64{1, 2, 3}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.Int64Value(1), value.Int64Value(2), value.Int64Value(3)),
+			dst:  func(v [3]int64) *[3]int64 { return &v }([3]int64{}),
+			exp:  func(v [3]int64) *[3]int64 { return &v }([3]int64{1, 2, 3}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.Int64Value(1), value.Int64Value(2), value.Int64Value(3)),
+			dst:  func(v [2]int64) *[2]int64 { return &v }([2]int64{}),
+			err:  value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.TextValue("a"), value.TextValue("b")),
+			dst:  func(v []int64) *[]int64 { return &v }(nil),
+			err:  value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.Int64Value(1)),
+			dst:  func(v map[int64]int64) *map[int64]int64 { return &v }(nil),
+			err:  value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.ListValue(
+				value.OptionalValue(value.Int64Value(1)),
+				value.NullValue(types.Int64),
+			),
+			dst: func(v []*int64) *[]*int64 { return &v }(nil),
+			exp: func(v []*int64) *[]*int64 { return &v }([]*int64{
+				func(v int64) *int64 { return &v }(1),
+				nil,
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.ListValue(value.Int64Value(1))),
+			dst:  func(v *[]int64) **[]int64 { return &v }(nil),
+			exp: func(v []int64) **[]int64 {
+				vv := &v
+
+				return &vv
+			}([]int64{1}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.NullValue(types.NewList(types.Int64)),
+			dst:  func(v []int64) *[]int64 { return &v }([]int64{1}),
+			exp:  func(v []int64) *[]int64 { return &v }(nil),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.TextValue("a"), value.TextValue("b")),
+			dst:  func(v []textUnmarshaler) *[]textUnmarshaler { return &v }(nil),
+			exp:  func(v []textUnmarshaler) *[]textUnmarshaler { return &v }([]textUnmarshaler{{"A"}, {"B"}}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.SetValue(value.TextValue("a"), value.TextValue("b")),
+			dst:  func(v map[string]struct{}) *map[string]struct{} { return &v }(nil),
+			exp: func(v map[string]struct{}) *map[string]struct{} { return &v }(map[string]struct{}{
+				"a": {},
+				"b": {},
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.SetValue(value.TextValue("a"), value.TextValue("b")),
+			dst:  func(v map[string]bool) *map[string]bool { return &v }(nil),
+			exp: func(v map[string]bool) *map[string]bool { return &v }(map[string]bool{
+				"a": true,
+				"b": true,
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.SetValue(value.TextValue("a")),
+			dst:  func(v []string) *[]string { return &v }(nil),
+			exp:  func(v []string) *[]string { return &v }([]string{"a"}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.DictValue(
+				value.DictValueField{K: value.TextValue("a"), V: value.Int64Value(1)},
+				value.DictValueField{K: value.TextValue("b"), V: value.Int64Value(2)},
+			),
+			dst: func(v map[string]int64) *map[string]int64 { return &v }(nil),
+			exp: func(v map[string]int64) *map[string]int64 { return &v }(map[string]int64{
+				"a": 1,
+				"b": 2,
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.DictValue(
+				value.DictValueField{K: value.TextValue("a"), V: value.ListValue(value.Int64Value(1))},
+			),
+			dst: func(v map[string][]int64) *map[string][]int64 { return &v }(nil),
+			exp: func(v map[string][]int64) *map[string][]int64 { return &v }(map[string][]int64{
+				"a": {1},
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.DictValue(
+				value.DictValueField{K: value.TextValue("a"), V: value.TextValue("b")},
+			),
+			dst: func(v map[string]int64) *map[string]int64 { return &v }(nil),
+			err: value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.DictValue(
+				value.DictValueField{K: value.TextValue("a"), V: value.Int64Value(1)},
+			),
+			dst: func(v []int64) *[]int64 { return &v }(nil),
+			err: value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TupleValue(value.Int64Value(1), value.TextValue("a")),
+			dst: func(v struct {
+				A int64
+				B string
+			},
+			) *struct {
+				A int64
+				B string
+			} {
+				return &v
+			}(struct {
+				A int64
+				B string
+			}{}),
+			exp: func(v struct {
+				A int64
+				B string
+			},
+			) *struct {
+				A int64
+				B string
+			} {
+				return &v
+			}(struct {
+				A int64
+				B string
+			}{A: 1, B: "a"}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TupleValue(value.Int64Value(1), value.Int64Value(2)),
+			dst:  func(v []int64) *[]int64 { return &v }(nil),
+			exp:  func(v []int64) *[]int64 { return &v }([]int64{1, 2}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TupleValue(value.Int64Value(1)),
+			dst:  func(v int64) *int64 { return &v }(0),
+			exp:  func(v int64) *int64 { return &v }(1),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TupleValue(value.Int64Value(1), value.TextValue("a")),
+			dst: func(v struct {
+				A int64
+			},
+			) *struct {
+				A int64
+			} {
+				return &v
+			}(struct {
+				A int64
+			}{}),
+			err: value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.ListValue(
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+					value.StructValueField{Name: "name", V: value.TextValue("first")},
+					value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("a"))},
+					value.StructValueField{Name: "meta", V: value.OptionalValue(value.TextValue("meta"))},
+					value.StructValueField{Name: "attr", V: value.DictValue(
+						value.DictValueField{K: value.TextValue("x"), V: value.Int64Value(1)},
+					)},
+					value.StructValueField{Name: "deep", V: value.OptionalValue(value.StructValue(
+						value.StructValueField{Name: "level", V: value.Int32Value(2)},
+					))},
+				),
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+					value.StructValueField{Name: "name", V: value.TextValue("second")},
+					value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("b"))},
+					value.StructValueField{Name: "meta", V: value.NullValue(types.Text)},
+					value.StructValueField{Name: "attr", V: value.DictValue(
+						value.DictValueField{K: value.TextValue("x"), V: value.Int64Value(1)},
+					)},
+					value.StructValueField{Name: "deep", V: value.NullValue(types.NewStruct(
+						types.StructField{Name: "level", T: types.Int32},
+					))},
+				),
+			),
+			dst: func(v []nestedItem) *[]nestedItem { return &v }(nil),
+			exp: func(v []nestedItem) *[]nestedItem { return &v }([]nestedItem{
+				{
+					ID:   1,
+					Name: "first",
+					Tags: []string{"a"},
+					Meta: &textUnmarshaler{text: "META"},
+					Attr: map[string]int64{"x": 1},
+					Deep: &nestedItemDetail{Level: 2},
+				},
+				{
+					ID:   2,
+					Name: "second",
+					Tags: []string{"b"},
+					Attr: map[string]int64{"x": 1},
+				},
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.StructValue(
+				value.StructValueField{Name: "level", V: value.Int32Value(2)},
+				value.StructValueField{Name: "unknown", V: value.Int32Value(2)},
+			),
+			dst: func(v nestedItemDetail) *nestedItemDetail { return &v }(nestedItemDetail{}),
+			err: ErrFieldsNotFoundInStruct,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.StructValue(
+				value.StructValueField{Name: "level", V: value.Int32Value(2)},
+				value.StructValueField{Name: "unknown", V: value.Int32Value(2)},
+			),
+			dst: func(v nestedItemDetail) *nestedItemDetail { return &v }(nestedItemDetail{}),
+			exp: func(v nestedItemDetail) *nestedItemDetail { return &v }(nestedItemDetail{Level: 2}),
+			settings: func(settings *scanStructSettings) {
+				settings.AllowMissingFieldsInStruct = true
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.StructValue(),
+			dst:  func(v nestedItemDetail) *nestedItemDetail { return &v }(nestedItemDetail{}),
+			err:  errFieldsNotFoundInStructValue,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.StructValue(),
+			dst:  func(v nestedItemDetail) *nestedItemDetail { return &v }(nestedItemDetail{}),
+			exp:  func(v nestedItemDetail) *nestedItemDetail { return &v }(nestedItemDetail{}),
+			settings: func(settings *scanStructSettings) {
+				settings.AllowMissingColumnsFromSelect = true
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.StructValue(
+				value.StructValueField{Name: "lvl", V: value.Int32Value(2)},
+			),
+			dst: func(v struct {
+				Level int32 `json:"lvl"`
+			},
+			) *struct {
+				Level int32 `json:"lvl"`
+			} {
+				return &v
+			}(struct {
+				Level int32 `json:"lvl"`
+			}{}),
+			exp: func(v struct {
+				Level int32 `json:"lvl"`
+			},
+			) *struct {
+				Level int32 `json:"lvl"`
+			} {
+				return &v
+			}(struct {
+				Level int32 `json:"lvl"`
+			}{Level: 2}),
+			settings: func(settings *scanStructSettings) {
+				settings.TagName = "json"
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.StructValue(
+				value.StructValueField{Name: "a", V: value.Int32Value(1)},
+				value.StructValueField{Name: "b", V: value.Int32Value(2)},
+			),
+			dst: func(v map[string]int32) *map[string]int32 { return &v }(nil),
+			exp: func(v map[string]int32) *map[string]int32 { return &v }(map[string]int32{
+				"a": 1,
+				"b": 2,
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.StructValue(
+				value.StructValueField{Name: "a", V: value.Int32Value(1)},
+			),
+			dst: func(v []int32) *[]int32 { return &v }(nil),
+			err: value.ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.VariantValueStruct(value.ListValue(value.Int32Value(1)), "a", types.NewVariantStruct(
+				types.StructField{Name: "a", T: types.NewList(types.Int32)},
+				types.StructField{Name: "b", T: types.Text},
+			)),
+			dst: func(v []int32) *[]int32 { return &v }(nil),
+			exp: func(v []int32) *[]int32 { return &v }([]int32{1}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: value.VariantValueTuple(value.TextValue("test"), 1, types.NewVariantTuple(
+				types.NewList(types.Int32),
+				types.Text,
+			)),
+			dst: func(v string) *string { return &v }(""),
+			exp: func(v string) *string { return &v }("test"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := defaultScanStructSettings()
+			if tt.settings != nil {
+				tt.settings(&settings)
+			}
+			err := castTo(tt.src, tt.dst, &settings)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.exp, tt.dst)
+			}
+		})
+	}
+}
+
+func TestScanStructWithNestedContainers(t *testing.T) {
+	scanner := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "items",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_ListType{
+						ListType: &Ydb.ListType{
+							Item: &Ydb.Type{
+								Type: &Ydb.Type_StructType{
+									StructType: &Ydb.StructType{
+										Members: []*Ydb.StructMember{
+											{
+												Name: "level",
+												Type: &Ydb.Type{
+													Type: &Ydb.Type_TypeId{
+														TypeId: Ydb.Type_INT32,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "counters",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_DictType{
+						DictType: &Ydb.DictType{
+							Key: &Ydb.Type{
+								Type: &Ydb.Type_TypeId{
+									TypeId: Ydb.Type_UTF8,
+								},
+							},
+							Payload: &Ydb.Type{
+								Type: &Ydb.Type_TypeId{
+									TypeId: Ydb.Type_INT64,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		[]*Ydb.Value{
+			{
+				Items: []*Ydb.Value{
+					{
+						Items: []*Ydb.Value{
+							{
+								Value: &Ydb.Value_Int32Value{
+									Int32Value: 1,
+								},
+							},
+						},
+					},
+					{
+						Items: []*Ydb.Value{
+							{
+								Value: &Ydb.Value_Int32Value{
+									Int32Value: 2,
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Pairs: []*Ydb.ValuePair{
+					{
+						Key: &Ydb.Value{
+							Value: &Ydb.Value_TextValue{
+								TextValue: "a",
+							},
+						},
+						Payload: &Ydb.Value{
+							Value: &Ydb.Value_Int64Value{
+								Int64Value: 42,
+							},
+						},
+					},
+				},
+			},
+		},
+	))
+	var dst struct {
+		Items    []nestedItemDetail `sql:"items"`
+		Counters map[string]int64   `sql:"counters"`
+	}
+	require.NoError(t, scanner.ScanStruct(&dst))
+	require.Equal(t, []nestedItemDetail{{Level: 1}, {Level: 2}}, dst.Items)
+	require.Equal(t, map[string]int64{"a": 42}, dst.Counters)
+}
@@ -7,6 +7,7 @@ import (
 var (
 	ErrColumnsNotFoundInRow               = errors.New("some columns not found in row")
 	ErrFieldsNotFoundInStruct             = errors.New("some fields not found in struct")
+	errFieldsNotFoundInStructValue        = errors.New("some fields not found in struct value")
 	errIncompatibleColumnsAndDestinations = errors.New("incompatible columns and destinations")
 	errDstTypeIsNotAPointer               = errors.New("dst type is not a pointer")
 	errDstTypeIsNotAPointerToStruct       = errors.New("dst type is not a pointer to struct")
@@ -3,7 +3,6 @@ package scanner
 import (
 	"fmt"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -26,9 +25,10 @@ func (s IndexedScanner) Scan(dst ...interface{}) (err error) {
 			),
 		)
 	}
+	settings := defaultScanStructSettings()
 	for i := range dst {
 		v := s.data.seekByIndex(i)
-		if err := value.CastTo(v, dst[i]); err != nil {
+		if err := castTo(v, dst[i], &settings); err != nil {
 			return xerrors.WithStackTrace(fmt.Errorf("scan error on column index %d: %w", i, err))
 		}
 	}
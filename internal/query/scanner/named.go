@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -51,12 +50,13 @@ func Named(data *data) NamedScanner {
 }
 
 func (s NamedScanner) ScanNamed(dst ...NamedDestination) (err error) {
+	settings := defaultScanStructSettings()
 	for i := range dst {
 		v, err := s.data.seekByName(dst[i].Name())
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
-		if err = value.CastTo(v, dst[i].Ref()); err != nil {
+		if err = castTo(v, dst[i].Ref(), &settings); err != nil {
 			return xerrors.WithStackTrace(fmt.Errorf("scan error on column name '%s': %w", dst[i].Name(), err))
 		}
 	}
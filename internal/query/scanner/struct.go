@@ -5,7 +5,6 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -33,12 +32,16 @@ func fieldName(f reflect.StructField, tagName string) string { //nolint:gocritic
 	return f.Name
 }
 
-func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (err error) {
-	settings := scanStructSettings{
+func defaultScanStructSettings() scanStructSettings {
+	return scanStructSettings{
 		TagName:                       "sql",
 		AllowMissingColumnsFromSelect: false,
 		AllowMissingFieldsInStruct:    false,
 	}
+}
+
+func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (err error) {
+	settings := defaultScanStructSettings()
 	for _, opt := range opts {
 		if opt != nil {
 			opt.applyScanStructOption(&settings)
@@ -64,7 +67,7 @@ func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (er
 		if err != nil {
 			missingColumns = append(missingColumns, name)
 		} else {
-			if err = value.CastTo(v, ptr.Elem().Field(i).Addr().Interface()); err != nil {
+			if err = castTo(v, ptr.Elem().Field(i).Addr().Interface(), &settings); err != nil {
 				return xerrors.WithStackTrace(fmt.Errorf("scan error on struct field name '%s': %w", name, err))
 			}
 			existingFields[name] = struct{}{}
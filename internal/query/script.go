@@ -0,0 +1,293 @@
+package query
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+var (
+	_ query.ScriptExecution = (*scriptExecution)(nil)
+	_ query.ResultSet       = (*scriptResultSet)(nil)
+)
+
+// scriptPollBackoff defines delays between polls of script execution status: 100ms, 200ms, ..., 6.4s
+var scriptPollBackoff backoff.Backoff = backoff.New(
+	backoff.WithSlotDuration(100*time.Millisecond),
+	backoff.WithCeiling(6),
+	backoff.WithJitterLimit(1),
+)
+
+type (
+	scriptExecution struct {
+		id         string
+		client     Ydb_Query_V1.QueryServiceClient
+		operations Ydb_Operation_V1.OperationServiceClient
+		backoff    backoff.Backoff
+
+		mu       sync.Mutex
+		metadata *options.MetadataExecuteQuery
+	}
+	scriptResultSet struct {
+		index       int64
+		execution   *scriptExecution
+		columnNames []string
+		columnTypes []types.Type
+		opts        []options.FetchScriptOption
+
+		page      result.Set
+		nextToken string
+		fetched   bool
+	}
+)
+
+func newScriptExecution(id string,
+	client Ydb_Query_V1.QueryServiceClient, operations Ydb_Operation_V1.OperationServiceClient,
+) *scriptExecution {
+	return &scriptExecution{
+		id:         id,
+		client:     client,
+		operations: operations,
+		backoff:    scriptPollBackoff,
+	}
+}
+
+func (e *scriptExecution) ID() string {
+	return e.id
+}
+
+func (e *scriptExecution) Wait(ctx context.Context) (*options.MetadataExecuteQuery, error) {
+	e.mu.Lock()
+	metadata := e.metadata
+	e.mu.Unlock()
+
+	if metadata != nil {
+		return metadata, nil
+	}
+
+	for i := 0; ; i++ {
+		op, err := getScriptOperation(ctx, e.operations, e.id)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		if op.GetReady() {
+			if op.GetStatus() != Ydb.StatusIds_SUCCESS {
+				return nil, xerrors.WithStackTrace(xerrors.Operation(
+					xerrors.WithStatusCode(op.GetStatus()),
+					xerrors.WithIssues(op.GetIssues()),
+				))
+			}
+
+			metadata = &options.MetadataExecuteQuery{}
+			if op.GetMetadata() != nil {
+				metadata = options.ToMetadataExecuteQuery(op.GetMetadata())
+			}
+
+			e.mu.Lock()
+			e.metadata = metadata
+			e.mu.Unlock()
+
+			return metadata, nil
+		}
+
+		t := time.NewTimer(e.backoff.Delay(i))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+
+			return nil, xerrors.WithStackTrace(ctx.Err())
+		case <-t.C:
+		}
+	}
+}
+
+func getScriptOperation(ctx context.Context,
+	client Ydb_Operation_V1.OperationServiceClient, opID string,
+) (*Ydb_Operations.Operation, error) {
+	op, err := retry.RetryWithResult(ctx, func(ctx context.Context) (*Ydb_Operations.Operation, error) {
+		response, err := client.GetOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.GetOperationRequest{
+			Id: opID,
+		})
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return response.GetOperation(), nil
+	}, retry.WithIdempotent(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+func (e *scriptExecution) Cancel(ctx context.Context) error {
+	err := retry.Retry(ctx, func(ctx context.Context) error {
+		response, err := e.operations.CancelOperation(conn.WithoutWrapping(ctx),
+			&Ydb_Operations.CancelOperationRequest{
+				Id: e.id,
+			},
+		)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return xerrors.WithStackTrace(xerrors.Operation(
+				xerrors.WithStatusCode(response.GetStatus()),
+				xerrors.WithIssues(response.GetIssues()),
+			))
+		}
+
+		return nil
+	}, retry.WithIdempotent(true))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (e *scriptExecution) Forget(ctx context.Context) error {
+	err := retry.Retry(ctx, func(ctx context.Context) error {
+		response, err := e.operations.ForgetOperation(conn.WithoutWrapping(ctx),
+			&Ydb_Operations.ForgetOperationRequest{
+				Id: e.id,
+			},
+		)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return xerrors.WithStackTrace(xerrors.Operation(
+				xerrors.WithStatusCode(response.GetStatus()),
+				xerrors.WithIssues(response.GetIssues()),
+			))
+		}
+
+		return nil
+	}, retry.WithIdempotent(true))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (e *scriptExecution) ResultSets(ctx context.Context,
+	opts ...options.FetchScriptOption,
+) xiter.Seq2[result.Set, error] {
+	return func(yield func(result.Set, error) bool) {
+		metadata, err := e.Wait(ctx)
+		if err != nil {
+			yield(nil, xerrors.WithStackTrace(err))
+
+			return
+		}
+
+		for i := range metadata.ResultSetsMeta {
+			rs := &scriptResultSet{
+				index:     int64(i),
+				execution: e,
+				opts:      opts,
+			}
+			for _, c := range metadata.ResultSetsMeta[i].Columns {
+				rs.columnNames = append(rs.columnNames, c.Name)
+				rs.columnTypes = append(rs.columnTypes, c.Type)
+			}
+			if !yield(rs, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (e *scriptExecution) Rows(ctx context.Context,
+	opts ...options.FetchScriptOption,
+) xiter.Seq2[result.Row, error] {
+	return func(yield func(result.Row, error) bool) {
+		e.ResultSets(ctx, opts...)(func(rs result.Set, err error) bool {
+			if err != nil {
+				yield(nil, err)
+
+				return false
+			}
+
+			cont := true
+			rs.Rows(ctx)(func(row result.Row, err error) bool {
+				cont = yield(row, err) && err == nil
+
+				return cont
+			})
+
+			return cont
+		})
+	}
+}
+
+func (rs *scriptResultSet) Index() int {
+	return int(rs.index)
+}
+
+func (rs *scriptResultSet) Columns() []string {
+	return rs.columnNames
+}
+
+func (rs *scriptResultSet) ColumnTypes() []types.Type {
+	return rs.columnTypes
+}
+
+func (rs *scriptResultSet) NextRow(ctx context.Context) (query.Row, error) {
+	for {
+		if rs.page != nil {
+			row, err := rs.page.NextRow(ctx)
+			if err == nil {
+				return row, nil
+			}
+			if !xerrors.Is(err, io.EOF) {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+
+		if rs.fetched && rs.nextToken == "" {
+			return nil, xerrors.WithStackTrace(io.EOF)
+		}
+
+		page, err := fetchScriptResults(ctx, rs.execution.client, rs.execution.id,
+			// opts shared by all result sets of the execution, copy before append
+			append(append([]options.FetchScriptOption(nil), rs.opts...),
+				options.WithResultSetIndex(rs.index),
+				options.WithFetchToken(rs.nextToken),
+			)...,
+		)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		rs.page = page.ResultSet
+		rs.nextToken = page.NextToken
+		rs.fetched = true
+	}
+}
+
+func (rs *scriptResultSet) Rows(ctx context.Context) xiter.Seq2[result.Row, error] {
+	return rangeRows(ctx, rs)
+}
@@ -0,0 +1,272 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testOperationServiceClient struct {
+	Ydb_Operation_V1.OperationServiceClient
+
+	operations   []*Ydb_Operations.Operation
+	getCalls     int
+	cancelStatus Ydb.StatusIds_StatusCode
+	forgetStatus Ydb.StatusIds_StatusCode
+}
+
+func (c *testOperationServiceClient) GetOperation(
+	ctx context.Context, in *Ydb_Operations.GetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	op := c.operations[c.getCalls]
+	c.getCalls++
+
+	return &Ydb_Operations.GetOperationResponse{
+		Operation: op,
+	}, nil
+}
+
+func (c *testOperationServiceClient) CancelOperation(
+	ctx context.Context, in *Ydb_Operations.CancelOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.CancelOperationResponse, error) {
+	return &Ydb_Operations.CancelOperationResponse{
+		Status: c.cancelStatus,
+	}, nil
+}
+
+func (c *testOperationServiceClient) ForgetOperation(
+	ctx context.Context, in *Ydb_Operations.ForgetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.ForgetOperationResponse, error) {
+	return &Ydb_Operations.ForgetOperationResponse{
+		Status: c.forgetStatus,
+	}, nil
+}
+
+func testScriptMetadata(t testing.TB, resultSetsCount int) *anypb.Any {
+	md := &Ydb_Query.ExecuteScriptMetadata{
+		ExecutionId: "123",
+	}
+	for i := 0; i < resultSetsCount; i++ {
+		md.ResultSetsMeta = append(md.ResultSetsMeta, &Ydb_Query.ResultSetMeta{
+			Columns: []*Ydb.Column{
+				{
+					Name: "id",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+				},
+			},
+		})
+	}
+	a, err := anypb.New(md)
+	require.NoError(t, err)
+
+	return a
+}
+
+func testScriptPage(nextToken string, ids ...uint64) *Ydb_Query.FetchScriptResultsResponse {
+	rs := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+			},
+		},
+	}
+	for _, id := range ids {
+		rs.Rows = append(rs.Rows, &Ydb.Value{
+			Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: id}}},
+		})
+	}
+
+	return &Ydb_Query.FetchScriptResultsResponse{
+		Status:         Ydb.StatusIds_SUCCESS,
+		ResultSet:      rs,
+		NextFetchToken: nextToken,
+	}
+}
+
+func TestScriptExecution(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("Wait", func(t *testing.T) {
+		t.Run("HappyWay", func(t *testing.T) {
+			operations := &testOperationServiceClient{
+				operations: []*Ydb_Operations.Operation{
+					{Id: "123"},
+					{Id: "123"},
+					{Id: "123", Ready: true, Status: Ydb.StatusIds_SUCCESS, Metadata: testScriptMetadata(t, 2)},
+				},
+			}
+			e := newScriptExecution("123", nil, operations)
+			e.backoff = backoff.New(backoff.WithSlotDuration(time.Millisecond))
+			metadata, err := e.Wait(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "123", metadata.ID)
+			require.Len(t, metadata.ResultSetsMeta, 2)
+			require.Equal(t, 3, operations.getCalls)
+			// completed script execution not polled again
+			_, err = e.Wait(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 3, operations.getCalls)
+		})
+		t.Run("Failed", func(t *testing.T) {
+			e := newScriptExecution("123", nil, &testOperationServiceClient{
+				operations: []*Ydb_Operations.Operation{
+					{Id: "123", Ready: true, Status: Ydb.StatusIds_GENERIC_ERROR},
+				},
+			})
+			_, err := e.Wait(ctx)
+			require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_GENERIC_ERROR))
+		})
+		t.Run("ContextDone", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			e := newScriptExecution("123", nil, &testOperationServiceClient{
+				operations: []*Ydb_Operations.Operation{
+					{Id: "123"},
+				},
+			})
+			e.backoff = backoff.New(backoff.WithSlotDuration(time.Hour))
+			time.AfterFunc(10*time.Millisecond, cancel)
+			_, err := e.Wait(ctx)
+			require.ErrorIs(t, err, context.Canceled)
+		})
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		e := newScriptExecution("123", nil, &testOperationServiceClient{
+			cancelStatus: Ydb.StatusIds_SUCCESS,
+		})
+		require.NoError(t, e.Cancel(ctx))
+	})
+	t.Run("Forget", func(t *testing.T) {
+		e := newScriptExecution("123", nil, &testOperationServiceClient{
+			forgetStatus: Ydb.StatusIds_BAD_REQUEST,
+		})
+		require.True(t, xerrors.IsOperationError(e.Forget(ctx), Ydb.StatusIds_BAD_REQUEST))
+	})
+	t.Run("Rows", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		pages := map[int64]map[string]*Ydb_Query.FetchScriptResultsResponse{
+			0: {
+				"":   testScriptPage("t1", 1, 2),
+				"t1": testScriptPage("t2"),
+				"t2": testScriptPage("", 3),
+			},
+			1: {
+				"": testScriptPage("", 4),
+			},
+		}
+		client.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.FetchScriptResultsRequest, opts ...grpc.CallOption) (
+				*Ydb_Query.FetchScriptResultsResponse, error,
+			) {
+				require.Equal(t, "123", in.GetOperationId())
+				require.EqualValues(t, 2, in.GetRowsLimit())
+				page := pages[in.GetResultSetIndex()][in.GetFetchToken()]
+				require.NotNil(t, page)
+				page.ResultSetIndex = in.GetResultSetIndex()
+
+				return page, nil
+			},
+		).Times(4)
+		e := newScriptExecution("123", client, &testOperationServiceClient{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "123", Ready: true, Status: Ydb.StatusIds_SUCCESS, Metadata: testScriptMetadata(t, 2)},
+			},
+		})
+		var ids []uint64
+		e.Rows(ctx, options.WithRowsLimit(2))(func(row result.Row, err error) bool {
+			require.NoError(t, err)
+			var id uint64
+			require.NoError(t, row.Scan(&id))
+			ids = append(ids, id)
+
+			return true
+		})
+		require.Equal(t, []uint64{1, 2, 3, 4}, ids)
+	})
+	t.Run("ResultSets", func(t *testing.T) {
+		e := newScriptExecution("123", nil, &testOperationServiceClient{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "123", Ready: true, Status: Ydb.StatusIds_SUCCESS, Metadata: testScriptMetadata(t, 3)},
+			},
+		})
+		var indexes []int
+		e.ResultSets(ctx)(func(rs result.Set, err error) bool {
+			require.NoError(t, err)
+			require.Equal(t, []string{"id"}, rs.Columns())
+			indexes = append(indexes, rs.Index())
+
+			return rs.Index() < 1
+		})
+		require.Equal(t, []int{0, 1}, indexes)
+	})
+	t.Run("ResultSetsConcurrentRead", func(t *testing.T) {
+		const resultSetsCount = 8
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.FetchScriptResultsRequest, opts ...grpc.CallOption) (
+				*Ydb_Query.FetchScriptResultsResponse, error,
+			) {
+				page := testScriptPage("", uint64(in.GetResultSetIndex()))
+				page.ResultSetIndex = in.GetResultSetIndex()
+
+				return page, nil
+			},
+		).Times(resultSetsCount)
+		e := newScriptExecution("123", client, &testOperationServiceClient{
+			operations: []*Ydb_Operations.Operation{
+				{
+					Id: "123", Ready: true, Status: Ydb.StatusIds_SUCCESS,
+					Metadata: testScriptMetadata(t, resultSetsCount),
+				},
+			},
+		})
+
+		// options slice with spare capacity is shared by all result sets
+		opts := make([]options.FetchScriptOption, 0, 10)
+		opts = append(opts, options.WithRowsLimit(1))
+
+		var resultSets []result.Set
+		e.ResultSets(ctx, opts...)(func(rs result.Set, err error) bool {
+			require.NoError(t, err)
+			resultSets = append(resultSets, rs)
+
+			return true
+		})
+		require.Len(t, resultSets, resultSetsCount)
+
+		ids := make([]uint64, resultSetsCount)
+		var wg sync.WaitGroup
+		for i := range resultSets {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				row, err := resultSets[i].NextRow(ctx)
+				if err == nil {
+					err = row.Scan(&ids[i])
+				}
+				require.NoError(t, err)
+			}(i)
+		}
+		wg.Wait()
+		for i := range ids {
+			require.EqualValues(t, i, ids[i])
+		}
+	})
+}
@@ -23,6 +23,7 @@ import (
 
 var (
 	_ query.Transaction  = (*Transaction)(nil)
+	_ query.TxHooks      = (*Transaction)(nil)
 	_ baseTx.Transaction = (*Transaction)(nil)
 )
 
@@ -319,6 +320,9 @@ func commitTx(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessi
 
 func (tx *Transaction) CommitTx(ctx context.Context) (finalErr error) {
 	if tx.ID() == baseTx.LazyTxID {
+		// nothing executed within lazy transaction, commit is no-op
+		tx.notifyOnCompleted(nil)
+
 		return nil
 	}
 
@@ -362,6 +366,10 @@ func rollback(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessi
 
 func (tx *Transaction) Rollback(ctx context.Context) (finalErr error) {
 	if tx.ID() == baseTx.LazyTxID {
+		if !tx.completed {
+			tx.notifyOnCompleted(ErrTransactionRollingBack)
+		}
+
 		// https://github.com/ydb-platform/ydb-go-sdk/issues/1456
 		return tx.s.Close(ctx)
 	}
@@ -394,6 +402,22 @@ func (tx *Transaction) OnCompleted(f baseTx.OnTransactionCompletedFunc) {
 	tx.onCompleted.Add(&f)
 }
 
+func (tx *Transaction) OnCommit(f func()) {
+	tx.OnCompleted(func(err error) {
+		if err == nil {
+			f()
+		}
+	})
+}
+
+func (tx *Transaction) OnRollback(f func(err error)) {
+	tx.OnCompleted(func(err error) {
+		if err != nil {
+			f(err)
+		}
+	})
+}
+
 func (tx *Transaction) waitOnBeforeCommit(ctx context.Context) (resErr error) {
 	tx.onBeforeCommit.Range(func(f *baseTx.OnTransactionBeforeCommit) bool {
 		resErr = (*f)(ctx)
@@ -407,6 +431,12 @@ func (tx *Transaction) waitOnBeforeCommit(ctx context.Context) (resErr error) {
 func (tx *Transaction) notifyOnCompleted(err error) {
 	tx.completed = true
 
+	tx.callOnCompleted(err)
+}
+
+// callOnCompleted calls completion callbacks without marking transaction as completed,
+// so transaction still can be rolled back on server-side
+func (tx *Transaction) callOnCompleted(err error) {
 	tx.onCompleted.Range(func(f *baseTx.OnTransactionCompletedFunc) bool {
 		(*f)(err)
 
@@ -185,6 +185,57 @@ func TestTxOnCompleted(t *testing.T) {
 		_ = tx.Rollback(sf.Context(e))
 		require.ErrorIs(t, completed, ErrTransactionRollingBack)
 	})
+	t.Run("OnCommitAndOnRollback", func(t *testing.T) {
+		t.Run("Commit", func(t *testing.T) {
+			e := fixenv.New(t)
+
+			QueryGrpcMock(e).EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(
+				&Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil,
+			)
+
+			tx := TransactionOverGrpcMock(e)
+
+			var committed, rolledBack int
+			tx.OnCommit(func() {
+				committed++
+			})
+			tx.OnRollback(func(err error) {
+				rolledBack++
+			})
+			require.NoError(t, tx.CommitTx(sf.Context(e)))
+			require.NoError(t, tx.Rollback(sf.Context(e)))
+			require.Equal(t, 1, committed)
+			require.Equal(t, 0, rolledBack)
+		})
+		t.Run("Rollback", func(t *testing.T) {
+			e := fixenv.New(t)
+
+			QueryGrpcMock(e).EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(
+				&Ydb_Query.RollbackTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil,
+			)
+
+			tx := TransactionOverGrpcMock(e)
+
+			var (
+				committed int
+				reasons   []error
+			)
+			tx.OnCommit(func() {
+				committed++
+			})
+			tx.OnRollback(func(err error) {
+				reasons = append(reasons, err)
+			})
+			require.NoError(t, tx.Rollback(sf.Context(e)))
+			require.Equal(t, 0, committed)
+			require.Len(t, reasons, 1)
+			require.ErrorIs(t, reasons[0], ErrTransactionRollingBack)
+		})
+	})
 	t.Run("OnExecWithoutCommitTxSuccess", func(t *testing.T) {
 		e := fixenv.New(t)
 
@@ -537,6 +588,10 @@ func (s testExecuteSettings) CallOptions() []grpc.CallOption {
 	return s.callOptions
 }
 
+func (s testExecuteSettings) Err() error {
+	return nil
+}
+
 var _ executeSettings = testExecuteSettings{}
 
 type txMock func() *internal.Control
@@ -18,6 +18,12 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+var (
+	_ table.Client            = (*Client)(nil)
+	_ table.ReadinessWaiter   = (*Client)(nil)
+	_ table.PoolStatsProvider = (*Client)(nil)
+)
+
 // sessionBuilder is the interface that holds logic of creating sessions.
 type sessionBuilder func(ctx context.Context) (*session, error)
 
@@ -35,6 +41,8 @@ func New(ctx context.Context, cc grpc.ClientConnInterface, config *config.Config
 		},
 		pool: pool.New[*session, session](ctx,
 			pool.WithLimit[*session, session](config.SizeLimit()),
+			pool.WithMinSize[*session, session](config.PoolMinSize()),
+			pool.WithStatsCallback[*session, session](config.PoolStatsCallback()),
 			pool.WithItemUsageLimit[*session, session](config.SessionUsageLimit()),
 			pool.WithIdleTimeToLive[*session, session](config.IdleThreshold()),
 			pool.WithCreateItemTimeout[*session, session](config.CreateSessionTimeout()),
@@ -165,6 +173,25 @@ func (c *Client) isClosed() bool {
 	}
 }
 
+func (c *Client) Stats() table.PoolStats {
+	return c.pool.Stats()
+}
+
+func (c *Client) WaitReady(ctx context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	select {
+	case <-c.done:
+		return xerrors.WithStackTrace(errClosedClient)
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	case <-c.pool.Ready():
+		return nil
+	}
+}
+
 // Close deletes all stored sessions inside Client.
 // It also stops all underlying timers and goroutines.
 // It returns first error occurred during stale sessions' deletion.
@@ -248,8 +275,15 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 		}
 
 		defer func() {
-			if err != nil && !xerrors.IsOperationError(err) {
-				_ = tx.Rollback(ctx)
+			if err != nil {
+				if t, ok := tx.(*transaction); ok {
+					// rollback callbacks receives the reason of failed attempt
+					t.notifyOnCompleted(err)
+				}
+
+				if !xerrors.IsOperationError(err) {
+					_ = tx.Rollback(ctx)
+				}
 			}
 		}()
 
@@ -6,6 +6,7 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -62,6 +63,26 @@ func WithSizeLimit(sizeLimit int) Option {
 	}
 }
 
+// WithPoolMinSize defines minimum count of sessions which pool prefills in background
+// after creating client and refills when sessions closed. If minSize is greater than
+// size limit then size limit is used as minimum size
+func WithPoolMinSize(minSize int) Option {
+	return func(c *Config) {
+		if minSize > 0 {
+			c.poolMinSize = minSize
+		}
+	}
+}
+
+// WithPoolStatsCallback defines callback which called with snapshot of session pool stats
+// periodically with interval
+func WithPoolStatsCallback(interval time.Duration, onStats func(stats pool.Stats)) Option {
+	return func(c *Config) {
+		c.poolStatsInterval = interval
+		c.onPoolStats = onStats
+	}
+}
+
 func WithPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	return func(c *Config) {
 		c.sessionUsageLimit = sessionUsageLimit
@@ -166,6 +187,7 @@ type Config struct {
 	config.Common
 
 	sizeLimit         int
+	poolMinSize       int
 	sessionUsageLimit uint64
 
 	createSessionTimeout time.Duration
@@ -174,6 +196,9 @@ type Config struct {
 
 	ignoreTruncated bool
 
+	poolStatsInterval time.Duration
+	onPoolStats       func(stats pool.Stats)
+
 	trace *trace.Table
 
 	clock clockwork.Clock
@@ -196,6 +221,17 @@ func (c *Config) SizeLimit() int {
 	return c.sizeLimit
 }
 
+// PoolMinSize is a minimum count of sessions which pool prefills in background
+// and keeps opened. Zero PoolMinSize means that sessions created on demand only
+func (c *Config) PoolMinSize() int {
+	return c.poolMinSize
+}
+
+// PoolStatsCallback returns interval and callback for periodic snapshots of session pool stats
+func (c *Config) PoolStatsCallback() (interval time.Duration, onStats func(stats pool.Stats)) {
+	return c.poolStatsInterval, c.onPoolStats
+}
+
 func (c *Config) SessionUsageLimit() uint64 {
 	return c.sessionUsageLimit
 }
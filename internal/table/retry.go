@@ -18,6 +18,7 @@ type sessionPool interface {
 	closer.Closer
 
 	Stats() pool.Stats
+	Ready() <-chan struct{}
 	With(ctx context.Context, f func(ctx context.Context, s *session) error, opts ...retry.Option) error
 }
 
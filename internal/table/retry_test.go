@@ -484,6 +484,13 @@ func (s *singleSession) Stats() pool.Stats {
 	}
 }
 
+func (s *singleSession) Ready() <-chan struct{} {
+	ready := make(chan struct{})
+	close(ready)
+
+	return ready
+}
+
 func (s *singleSession) With(ctx context.Context,
 	f func(ctx context.Context, s *session) error, opts ...retry.Option,
 ) error {
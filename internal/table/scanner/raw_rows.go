@@ -0,0 +1,45 @@
+package scanner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+var errRawRowsNotSupported = errors.New("result not supports raw rows")
+
+type rawRowsResult interface {
+	rawRows() (columns []*Ydb.Column, rows []*Ydb.Value)
+}
+
+// RawRows takes remaining rows of current result set without decoding of values.
+// Raw rows can be decoded with scanners of query service without intermediate converting of values.
+func RawRows(res result.BaseResult) (columns []*Ydb.Column, rows []*Ydb.Value, _ error) {
+	r, ok := res.(rawRowsResult)
+	if !ok {
+		return nil, nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errRawRowsNotSupported, res))
+	}
+	columns, rows = r.rawRows()
+	if err := res.Err(); err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	return columns, rows, nil
+}
+
+func (s *valueScanner) rawRows() (columns []*Ydb.Column, rows []*Ydb.Value) {
+	if s.err != nil || s.set == nil {
+		return nil, nil
+	}
+	rows = s.set.GetRows()[s.nextRow:]
+	s.nextRow = len(s.set.GetRows())
+	s.row = nil
+	s.nextItem = 0
+	s.stack.reset()
+
+	return s.set.GetColumns(), rows
+}
@@ -0,0 +1,43 @@
+package scanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRawRows(t *testing.T) {
+	uint64Type := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{{Name: "id", Type: uint64Type}},
+		Rows: []*Ydb.Value{
+			{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}}}},
+			{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}}}},
+			{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 3}}}},
+		},
+	}
+	t.Run("RemainingRows", func(t *testing.T) {
+		res := NewUnary([]*Ydb.ResultSet{set}, nil)
+		require.True(t, res.NextResultSet(context.Background()))
+		require.True(t, res.NextRow())
+		columns, rows, err := RawRows(res)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(set.GetColumns()[0], columns[0]))
+		require.Len(t, rows, 2)
+		require.True(t, proto.Equal(set.GetRows()[1], rows[0]))
+		require.True(t, proto.Equal(set.GetRows()[2], rows[1]))
+		require.False(t, res.NextRow())
+		require.False(t, res.NextResultSet(context.Background()))
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		truncated := proto.Clone(set).(*Ydb.ResultSet) //nolint:forcetypeassert
+		truncated.Truncated = true
+		res := NewUnary([]*Ydb.ResultSet{truncated}, nil)
+		require.True(t, res.NextResultSet(context.Background()))
+		_, _, err := RawRows(res)
+		require.Error(t, err)
+	})
+}
@@ -338,6 +338,8 @@ func (s *session) DescribeTable(
 		TimeToLiveSettings:   NewTimeToLiveSettings(result.GetTtlSettings()),
 		Changefeeds:          processChangefeeds(result.GetChangefeeds()),
 		Tiering:              result.GetTiering(),
+		StoreType:            options.StoreType(result.GetStoreType()),
+		PartitionBy:          result.GetPartitioningSettings().GetPartitionBy(),
 	}
 
 	return desc, nil
@@ -461,6 +463,8 @@ func processIndexes(indexes []*Ydb_Table.TableIndexDescription) []options.IndexD
 			typ = options.IndexTypeGlobalAsync
 		case *Ydb_Table.TableIndexDescription_GlobalIndex:
 			typ = options.IndexTypeGlobal
+		case *Ydb_Table.TableIndexDescription_GlobalUniqueIndex:
+			typ = options.IndexTypeGlobalUnique
 		}
 		idxs[i] = options.IndexDescription{
 			Name:         idx.GetName(),
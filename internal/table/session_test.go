@@ -169,6 +169,9 @@ func TestSessionDescribeTable(t *testing.T) {
 			},
 			Indexes:     []options.IndexDescription{},
 			Changefeeds: make([]options.ChangefeedDescription, 0),
+			Tiering:     "testTiering",
+			StoreType:   options.StoreTypeColumn,
+			PartitionBy: []string{"testKey"},
 		}
 		a := allocator.New()
 		defer a.Free()
@@ -203,6 +206,11 @@ func TestSessionDescribeTable(t *testing.T) {
 			},
 			ReadReplicasSettings: expect.ReadReplicaSettings.ToYDB(),
 			StorageSettings:      expect.StorageSettings.ToYDB(),
+			PartitioningSettings: &Ydb_Table.PartitioningSettings{
+				PartitionBy: expect.PartitionBy,
+			},
+			Tiering:   expect.Tiering,
+			StoreType: Ydb_Table.StoreType_STORE_TYPE_COLUMN,
 		}
 
 		d, err := s.DescribeTable(ctx, "")
@@ -14,6 +14,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
@@ -23,6 +24,7 @@ import (
 var (
 	errTxAlreadyCommitted = xerrors.Wrap(fmt.Errorf("transaction already committed"))
 	errTxRollbackedEarly  = xerrors.Wrap(fmt.Errorf("transaction rollbacked early"))
+	errTxRollingBack      = xerrors.Wrap(fmt.Errorf("transaction is rolling back"))
 )
 
 type txState struct {
@@ -45,7 +47,10 @@ const (
 	txStateRollbacked
 )
 
-var _ tx.Identifier = (*transaction)(nil)
+var (
+	_ tx.Identifier          = (*transaction)(nil)
+	_ table.TransactionHooks = (*transaction)(nil)
+)
 
 type transaction struct {
 	tx.Identifier
@@ -53,6 +58,8 @@ type transaction struct {
 	s       *session
 	control *table.TransactionControl
 	state   txState
+
+	onCompleted xsync.Set[*func(err error)]
 }
 
 // Execute executes query represented by text within transaction tx.
@@ -78,11 +85,16 @@ func (tx *transaction) Execute(
 	default:
 		_, r, err = tx.s.Execute(ctx, tx.control, query, parameters, opts...)
 		if err != nil {
+			if tx.control.Desc().GetCommitTx() {
+				tx.notifyOnCompleted(err)
+			}
+
 			return nil, xerrors.WithStackTrace(err)
 		}
 
 		if tx.control.Desc().GetCommitTx() {
 			tx.state.Store(txStateCommitted)
+			tx.notifyOnCompleted(nil)
 		}
 
 		return r, nil
@@ -120,11 +132,16 @@ func (tx *transaction) ExecuteStatement(
 	default:
 		_, r, err = stmt.Execute(ctx, tx.control, parameters, opts...)
 		if err != nil {
+			if tx.control.Desc().GetCommitTx() {
+				tx.notifyOnCompleted(err)
+			}
+
 			return nil, xerrors.WithStackTrace(err)
 		}
 
 		if tx.control.Desc().GetCommitTx() {
 			tx.state.Store(txStateCommitted)
+			tx.notifyOnCompleted(nil)
 		}
 
 		return r, nil
@@ -174,15 +191,20 @@ func (tx *transaction) CommitTx(
 
 		response, err = tx.s.tableService.CommitTransaction(ctx, request)
 		if err != nil {
+			tx.notifyOnCompleted(err)
+
 			return nil, xerrors.WithStackTrace(err)
 		}
 
 		err = response.GetOperation().GetResult().UnmarshalTo(result)
 		if err != nil {
+			tx.notifyOnCompleted(err)
+
 			return nil, xerrors.WithStackTrace(err)
 		}
 
 		tx.state.Store(txStateCommitted)
+		tx.notifyOnCompleted(nil)
 
 		return scanner.NewUnary(
 			nil,
@@ -209,6 +231,8 @@ func (tx *transaction) Rollback(ctx context.Context) (err error) {
 	case txStateRollbacked:
 		return xerrors.WithStackTrace(errTxRollbackedEarly)
 	default:
+		tx.notifyOnCompleted(errTxRollingBack)
+
 		_, err = tx.s.tableService.RollbackTransaction(ctx,
 			&Ydb_Table.RollbackTransactionRequest{
 				SessionId: tx.s.id,
@@ -230,3 +254,34 @@ func (tx *transaction) Rollback(ctx context.Context) (err error) {
 		return nil
 	}
 }
+
+// OnCommit registers callback which will be called after successful commit of transaction.
+func (tx *transaction) OnCommit(f func()) {
+	tx.onCompletedAdd(func(err error) {
+		if err == nil {
+			f()
+		}
+	})
+}
+
+// OnRollback registers callback which will be called on rollback or failed commit of transaction.
+func (tx *transaction) OnRollback(f func(err error)) {
+	tx.onCompletedAdd(func(err error) {
+		if err != nil {
+			f(err)
+		}
+	})
+}
+
+func (tx *transaction) onCompletedAdd(f func(err error)) {
+	tx.onCompleted.Add(&f)
+}
+
+// notifyOnCompleted calls each registered callback once with result of transaction
+func (tx *transaction) notifyOnCompleted(err error) {
+	tx.onCompleted.Range(func(f *func(err error)) bool {
+		(*f)(err)
+
+		return tx.onCompleted.Remove(f)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
@@ -125,3 +126,76 @@ func TestTxSkipRollbackForCommitted(t *testing.T) {
 		}
 	}
 }
+
+func TestTxOnCommitOnRollback(t *testing.T) {
+	b := StubBuilder{
+		T: t,
+		cc: testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableBeginTransaction: func(request interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{
+								Id: "",
+							},
+						}, nil
+					},
+					testutil.TableCommitTransaction: func(request interface{}) (proto.Message, error) {
+						return &Ydb_Table.CommitTransactionResult{}, nil
+					},
+					testutil.TableRollbackTransaction: func(request interface{}) (proto.Message, error) {
+						return &Ydb_Table.RollbackTransactionResponse{
+							Operation: &Ydb_Operations.Operation{
+								Ready:  true,
+								Status: Ydb.StatusIds_SUCCESS,
+							},
+						}, nil
+					},
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+				},
+			),
+		),
+	}
+	s, err := b.createSession(context.Background())
+	require.NoError(t, err)
+	t.Run("Commit", func(t *testing.T) {
+		x, err := s.BeginTransaction(context.Background(), table.TxSettings())
+		require.NoError(t, err)
+		hooks := x.(table.TransactionHooks) //nolint:forcetypeassert
+		var committed, rolledBack int
+		hooks.OnCommit(func() {
+			committed++
+		})
+		hooks.OnRollback(func(err error) {
+			rolledBack++
+		})
+		_, err = x.CommitTx(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, x.Rollback(context.Background()))
+		require.Equal(t, 1, committed)
+		require.Equal(t, 0, rolledBack)
+	})
+	t.Run("Rollback", func(t *testing.T) {
+		x, err := s.BeginTransaction(context.Background(), table.TxSettings())
+		require.NoError(t, err)
+		hooks := x.(table.TransactionHooks) //nolint:forcetypeassert
+		var (
+			committed int
+			reasons   []error
+		)
+		hooks.OnCommit(func() {
+			committed++
+		})
+		hooks.OnRollback(func(err error) {
+			reasons = append(reasons, err)
+		})
+		require.NoError(t, x.Rollback(context.Background()))
+		require.Equal(t, 0, committed)
+		require.Len(t, reasons, 1)
+		require.ErrorIs(t, reasons[0], errTxRollingBack)
+	})
+}
@@ -331,6 +331,41 @@ func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption
 	return topicwriter.NewWriter(writer), nil
 }
 
+// StartMultiWriter create new topic writer with one internal writer per partition
+func (c *Client) StartMultiWriter(
+	topicPath string,
+	opts ...topicoptions.MultiWriterOption,
+) (*topicwriter.MultiWriter, error) {
+	describe := func(ctx context.Context) ([]int64, error) {
+		desc, err := c.Describe(ctx, topicPath)
+		if err != nil {
+			return nil, err
+		}
+
+		partitions := make([]int64, 0, len(desc.Partitions))
+		for i := range desc.Partitions {
+			if desc.Partitions[i].Active {
+				partitions = append(partitions, desc.Partitions[i].PartitionID)
+			}
+		}
+
+		return partitions, nil
+	}
+
+	cfg := topicwriterinternal.NewMultiWriterConfig(
+		append([]topicoptions.MultiWriterOption{
+			topicwriterinternal.WithMultiWriterDescribePartitions(describe),
+			topicwriterinternal.WithMultiWriterWriterOptions(c.writerBaseOptions(topicPath)...),
+		}, opts...)...,
+	)
+	writer, err := topicwriterinternal.NewMultiWriter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return topicwriter.NewMultiWriter(writer), nil
+}
+
 func (c *Client) StartTransactionalWriter(
 	transaction tx.Identifier,
 	topicpath string,
@@ -356,6 +391,12 @@ func (c *Client) createWriterConfig(
 	topicPath string,
 	opts []topicoptions.WriterOption,
 ) topicwriterinternal.WriterReconnectorConfig {
+	options := append(c.writerBaseOptions(topicPath), opts...)
+
+	return topicwriterinternal.NewWriterReconnectorConfig(options...)
+}
+
+func (c *Client) writerBaseOptions(topicPath string) []topicoptions.WriterOption {
 	var connector topicwriterinternal.ConnectFunc = func(ctx context.Context) (
 		topicwriterinternal.RawTopicWriterStream,
 		error,
@@ -363,15 +404,11 @@ func (c *Client) createWriterConfig(
 		return c.rawClient.StreamWrite(ctx)
 	}
 
-	options := []topicoptions.WriterOption{
+	return []topicoptions.WriterOption{
 		topicwriterinternal.WithConnectFunc(connector),
 		topicwriterinternal.WithTopic(topicPath),
 		topicwriterinternal.WithCommonConfig(c.cfg.Common),
 		topicwriterinternal.WithTrace(c.cfg.Trace),
 		topicwriterinternal.WithCredentials(c.cred),
 	}
-
-	options = append(options, opts...)
-
-	return topicwriterinternal.NewWriterReconnectorConfig(options...)
 }
@@ -0,0 +1,174 @@
+// Package topiccodecs contains built-in implementations of topic codecs, which need external libraries
+package topiccodecs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+const (
+	// ZstdDefaultLevel is a default zstd compression level (zstd.SpeedDefault)
+	ZstdDefaultLevel = 3
+
+	// ZstdDefaultMaxDecodedSize is a default limit of decoded message size, same as default
+	// max message size of topic writer
+	ZstdDefaultMaxDecodedSize = 50 * 1024 * 1024
+)
+
+var (
+	errZstdWriterClosed = xerrors.Wrap(errors.New("ydb: write to closed zstd encoder"))
+
+	zstdEncodersMutex xsync.Mutex
+	zstdEncoders      = make(map[zstd.EncoderLevel]*zstd.Encoder)
+
+	zstdDecodersMutex xsync.Mutex
+	zstdDecoders      = make(map[uint64]*zstd.Decoder)
+
+	zstdBuffers xsync.Pool[zstdBuffer]
+)
+
+type zstdBuffer struct {
+	b []byte
+}
+
+// NewZstdEncoder returns fabric of zstd encoders with compression level in terms of zstd (1-22).
+// Encoders with the same level share one zstd.Encoder, which is safe for concurrent use.
+// Data compressed by one frame on close of encoder, so messages are kept in memory until close.
+func NewZstdEncoder(level int) func(writer io.Writer) (io.WriteCloser, error) {
+	encoderLevel := zstd.EncoderLevelFromZstd(level)
+
+	return func(writer io.Writer) (io.WriteCloser, error) {
+		encoder, err := getZstdEncoder(encoderLevel)
+		if err != nil {
+			return nil, err
+		}
+
+		return &zstdWriter{
+			encoder: encoder,
+			target:  writer,
+			buf:     zstdBuffers.GetOrNew(),
+		}, nil
+	}
+}
+
+func getZstdEncoder(level zstd.EncoderLevel) (*zstd.Encoder, error) {
+	zstdEncodersMutex.Lock()
+	defer zstdEncodersMutex.Unlock()
+
+	if encoder, ok := zstdEncoders[level]; ok {
+		return encoder, nil
+	}
+
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(level), zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	zstdEncoders[level] = encoder
+
+	return encoder, nil
+}
+
+type zstdWriter struct {
+	encoder *zstd.Encoder
+	target  io.Writer
+	buf     *zstdBuffer
+}
+
+func (w *zstdWriter) Write(p []byte) (int, error) {
+	if w.buf == nil {
+		return 0, xerrors.WithStackTrace(errZstdWriterClosed)
+	}
+
+	w.buf.b = append(w.buf.b, p...)
+
+	return len(p), nil
+}
+
+func (w *zstdWriter) Close() error {
+	if w.buf == nil {
+		return nil
+	}
+
+	dst := zstdBuffers.GetOrNew()
+	dst.b = w.encoder.EncodeAll(w.buf.b, dst.b[:0])
+	_, err := w.target.Write(dst.b)
+
+	w.buf.b = w.buf.b[:0]
+	zstdBuffers.Put(w.buf)
+	w.buf = nil
+	dst.b = dst.b[:0]
+	zstdBuffers.Put(dst)
+
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ZstdDecoder decodes zstd compressed input with ZstdDefaultMaxDecodedSize limit of decoded size
+func ZstdDecoder(input io.Reader) (io.Reader, error) {
+	return decodeZstd(input, ZstdDefaultMaxDecodedSize)
+}
+
+// NewZstdDecoder returns fabric of zstd decoders, which fail on messages with decoded size
+// greater than maxDecodedSize. It protects reader from decompression bombs.
+func NewZstdDecoder(maxDecodedSize uint64) func(input io.Reader) (io.Reader, error) {
+	return func(input io.Reader) (io.Reader, error) {
+		return decodeZstd(input, maxDecodedSize)
+	}
+}
+
+// getZstdDecoder returns shared zstd.Decoder for the limit, which is safe for concurrent use
+// and holds pool of internal decoders.
+func getZstdDecoder(maxDecodedSize uint64) (*zstd.Decoder, error) {
+	zstdDecodersMutex.Lock()
+	defer zstdDecodersMutex.Unlock()
+
+	if decoder, ok := zstdDecoders[maxDecodedSize]; ok {
+		return decoder, nil
+	}
+
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(0),
+		zstd.WithDecoderMaxMemory(maxDecodedSize),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	zstdDecoders[maxDecodedSize] = decoder
+
+	return decoder, nil
+}
+
+func decodeZstd(input io.Reader, maxDecodedSize uint64) (io.Reader, error) {
+	decoder, err := getZstdDecoder(maxDecodedSize)
+	if err != nil {
+		return nil, err
+	}
+
+	src := zstdBuffers.GetOrNew()
+	defer func() {
+		src.b = src.b[:0]
+		zstdBuffers.Put(src)
+	}()
+
+	buf := bytes.NewBuffer(src.b[:0])
+	if _, err := buf.ReadFrom(input); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	src.b = buf.Bytes()
+
+	decoded, err := decoder.DecodeAll(src.b, nil)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return bytes.NewReader(decoded), nil
+}
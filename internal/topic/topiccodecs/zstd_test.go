@@ -0,0 +1,109 @@
+package topiccodecs
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func zstdEncode(t testing.TB, level int, data []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewZstdEncoder(level)(&buf)
+	require.NoError(t, err)
+	_, err = w.Write(data[:len(data)/2])
+	require.NoError(t, err)
+	_, err = w.Write(data[len(data)/2:])
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, w.Close())
+
+	_, err = w.Write(data)
+	require.ErrorIs(t, err, errZstdWriterClosed)
+
+	return buf.Bytes()
+}
+
+func zstdDecode(t testing.TB, encoded []byte) []byte {
+	r, err := ZstdDecoder(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return decoded
+}
+
+func TestZstd(t *testing.T) {
+	data := []byte(strings.Repeat("topic message with repeated content ", 100))
+
+	t.Run("Levels", func(t *testing.T) {
+		for _, level := range []int{1, 3, 7, 19} {
+			encoded := zstdEncode(t, level, data)
+			require.Less(t, len(encoded), len(data))
+			require.Equal(t, data, zstdDecode(t, encoded))
+		}
+	})
+	t.Run("Empty", func(t *testing.T) {
+		require.Empty(t, zstdDecode(t, zstdEncode(t, ZstdDefaultLevel, nil)))
+	})
+	t.Run("Compatibility", func(t *testing.T) {
+		// stream encoded data, as written by other SDKs
+		var buf bytes.Buffer
+		w, err := zstd.NewWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		require.Equal(t, data, zstdDecode(t, buf.Bytes()))
+
+		decoder, err := zstd.NewReader(bytes.NewReader(zstdEncode(t, ZstdDefaultLevel, data)))
+		require.NoError(t, err)
+		defer decoder.Close()
+		decoded, err := io.ReadAll(decoder)
+		require.NoError(t, err)
+		require.Equal(t, data, decoded)
+	})
+	t.Run("MaxDecodedSize", func(t *testing.T) {
+		encoded := zstdEncode(t, ZstdDefaultLevel, data)
+
+		r, err := NewZstdDecoder(uint64(len(data)))(bytes.NewReader(encoded))
+		require.NoError(t, err)
+		decoded, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, decoded)
+
+		_, err = NewZstdDecoder(uint64(len(data) / 2))(bytes.NewReader(encoded))
+		require.ErrorIs(t, err, zstd.ErrDecoderSizeExceeded)
+
+		// frame without content size in header
+		var buf bytes.Buffer
+		w, err := zstd.NewWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		_, err = NewZstdDecoder(uint64(len(data) / 2))(bytes.NewReader(buf.Bytes()))
+		require.Error(t, err)
+	})
+	t.Run("Broken", func(t *testing.T) {
+		_, err := ZstdDecoder(bytes.NewReader([]byte("not a zstd frame")))
+		require.Error(t, err)
+	})
+	t.Run("Concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				message := append([]byte{byte(i)}, data...)
+				require.Equal(t, message, zstdDecode(t, zstdEncode(t, ZstdDefaultLevel, message)))
+			}(i)
+		}
+		wg.Wait()
+	})
+}
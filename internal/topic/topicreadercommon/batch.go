@@ -117,6 +117,12 @@ func (m *PublicBatch) PartitionID() int64 {
 	return m.partitionSession().PartitionID
 }
 
+// PartitionSessionID is id of the partition session, which received the batch.
+// It is used for control of the partition session, for example for pause or seek the partition.
+func (m *PublicBatch) PartitionSessionID() int64 {
+	return m.partitionSession().ClientPartitionSessionID
+}
+
 func (m *PublicBatch) partitionSession() *PartitionSession {
 	return m.commitRange.PartitionSession
 }
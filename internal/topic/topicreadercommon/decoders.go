@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topiccodecs"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -23,6 +24,7 @@ func NewDecoderMap() DecoderMap {
 			rawtopiccommon.CodecGzip: func(input io.Reader) (io.Reader, error) {
 				return gzip.NewReader(input)
 			},
+			rawtopiccommon.CodecZstd: topiccodecs.ZstdDecoder,
 		},
 	}
 }
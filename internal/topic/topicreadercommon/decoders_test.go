@@ -0,0 +1,30 @@
+package topicreadercommon
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+)
+
+func TestDecoderMap_Zstd(t *testing.T) {
+	data := []byte(strings.Repeat("zstd", 100))
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	encoded := encoder.EncodeAll(data, nil)
+
+	m := NewDecoderMap()
+	r, err := m.Decode(rawtopiccommon.CodecZstd, bytes.NewReader(encoded))
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	_, err = m.Decode(rawtopiccommon.CodecLzop, bytes.NewReader(encoded))
+	require.ErrorIs(t, err, ErrPublicUnexpectedCodec)
+}
@@ -6,4 +6,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
-var PublicErrCommitSessionToExpiredSession = xerrors.Wrap(errors.New("ydb: commit to expired session"))
+var (
+	PublicErrCommitSessionToExpiredSession = xerrors.Wrap(errors.New("ydb: commit to expired session"))
+	PublicErrPartitionSessionExpired       = xerrors.Wrap(errors.New("ydb: partition session not found or expired"))
+)
@@ -47,6 +47,12 @@ func (m *PublicMessage) PartitionID() int64 {
 	return m.commitRange.session().PartitionID
 }
 
+// PartitionSessionID is id of the partition session, which received the message.
+// It is used for control of the partition session, for example for pause or seek the partition.
+func (m *PublicMessage) PartitionSessionID() int64 {
+	return m.commitRange.session().ClientPartitionSessionID
+}
+
 func (m *PublicMessage) getCommitRange() PublicCommitRange {
 	return m.commitRange.getCommitRange()
 }
@@ -89,6 +89,14 @@ func (s *PartitionSession) SetCommittedOffsetForward(v rawtopiccommon.Offset) {
 	}
 }
 
+// SetReadOffset moves the session to read from the offset, it must be called before receive messages of the session
+func (s *PartitionSession) SetReadOffset(offset rawtopiccommon.Offset, commitsEnabled bool) {
+	if commitsEnabled {
+		s.committedOffsetVal.Store(offset.ToInt64())
+	}
+	s.lastReceivedOffsetEndVal.Store(offset.ToInt64() - 1)
+}
+
 func (s *PartitionSession) LastReceivedMessageOffset() rawtopiccommon.Offset {
 	v := s.lastReceivedOffsetEndVal.Load()
 
@@ -2,6 +2,7 @@ package topicreaderinternal
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
@@ -15,4 +16,8 @@ type batchedStreamReader interface {
 	Commit(ctx context.Context, commitRange topicreadercommon.CommitRange) error
 	CloseWithError(ctx context.Context, err error) error
 	PopMessagesBatchTx(ctx context.Context, tx tx.Transaction, opts ReadMessageBatchOptions) (*topicreadercommon.PublicBatch, error) //nolint:lll
+	Seek(ctx context.Context, partitionSessionID, offset int64) error
+	SeekToTime(ctx context.Context, readFrom time.Time) error
+	Pause(ctx context.Context, partitionSessionID int64) error
+	Resume(ctx context.Context, partitionSessionID int64) error
 }
@@ -9,6 +9,7 @@ package topicreaderinternal
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	topicreadercommon "github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
 	tx "github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
@@ -114,6 +115,44 @@ func (c *MockbatchedStreamReaderCommitCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// Pause mocks base method.
+func (m *MockbatchedStreamReader) Pause(ctx context.Context, partitionSessionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, partitionSessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockbatchedStreamReaderMockRecorder) Pause(ctx, partitionSessionID any) *MockbatchedStreamReaderPauseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockbatchedStreamReader)(nil).Pause), ctx, partitionSessionID)
+	return &MockbatchedStreamReaderPauseCall{Call: call}
+}
+
+// MockbatchedStreamReaderPauseCall wrap *gomock.Call
+type MockbatchedStreamReaderPauseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderPauseCall) Return(arg0 error) *MockbatchedStreamReaderPauseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderPauseCall) Do(f func(context.Context, int64) error) *MockbatchedStreamReaderPauseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderPauseCall) DoAndReturn(f func(context.Context, int64) error) *MockbatchedStreamReaderPauseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // PopMessagesBatchTx mocks base method.
 func (m *MockbatchedStreamReader) PopMessagesBatchTx(ctx context.Context, tx tx.Transaction, opts ReadMessageBatchOptions) (*topicreadercommon.PublicBatch, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +231,120 @@ func (c *MockbatchedStreamReaderReadMessageBatchCall) DoAndReturn(f func(context
 	return c
 }
 
+// Resume mocks base method.
+func (m *MockbatchedStreamReader) Resume(ctx context.Context, partitionSessionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, partitionSessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockbatchedStreamReaderMockRecorder) Resume(ctx, partitionSessionID any) *MockbatchedStreamReaderResumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockbatchedStreamReader)(nil).Resume), ctx, partitionSessionID)
+	return &MockbatchedStreamReaderResumeCall{Call: call}
+}
+
+// MockbatchedStreamReaderResumeCall wrap *gomock.Call
+type MockbatchedStreamReaderResumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderResumeCall) Return(arg0 error) *MockbatchedStreamReaderResumeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderResumeCall) Do(f func(context.Context, int64) error) *MockbatchedStreamReaderResumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderResumeCall) DoAndReturn(f func(context.Context, int64) error) *MockbatchedStreamReaderResumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Seek mocks base method.
+func (m *MockbatchedStreamReader) Seek(ctx context.Context, partitionSessionID, offset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seek", ctx, partitionSessionID, offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Seek indicates an expected call of Seek.
+func (mr *MockbatchedStreamReaderMockRecorder) Seek(ctx, partitionSessionID, offset any) *MockbatchedStreamReaderSeekCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockbatchedStreamReader)(nil).Seek), ctx, partitionSessionID, offset)
+	return &MockbatchedStreamReaderSeekCall{Call: call}
+}
+
+// MockbatchedStreamReaderSeekCall wrap *gomock.Call
+type MockbatchedStreamReaderSeekCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderSeekCall) Return(arg0 error) *MockbatchedStreamReaderSeekCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderSeekCall) Do(f func(context.Context, int64, int64) error) *MockbatchedStreamReaderSeekCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderSeekCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockbatchedStreamReaderSeekCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SeekToTime mocks base method.
+func (m *MockbatchedStreamReader) SeekToTime(ctx context.Context, readFrom time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeekToTime", ctx, readFrom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeekToTime indicates an expected call of SeekToTime.
+func (mr *MockbatchedStreamReaderMockRecorder) SeekToTime(ctx, readFrom any) *MockbatchedStreamReaderSeekToTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekToTime", reflect.TypeOf((*MockbatchedStreamReader)(nil).SeekToTime), ctx, readFrom)
+	return &MockbatchedStreamReaderSeekToTimeCall{Call: call}
+}
+
+// MockbatchedStreamReaderSeekToTimeCall wrap *gomock.Call
+type MockbatchedStreamReaderSeekToTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderSeekToTimeCall) Return(arg0 error) *MockbatchedStreamReaderSeekToTimeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderSeekToTimeCall) Do(f func(context.Context, time.Time) error) *MockbatchedStreamReaderSeekToTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderSeekToTimeCall) DoAndReturn(f func(context.Context, time.Time) error) *MockbatchedStreamReaderSeekToTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WaitInit mocks base method.
 func (m *MockbatchedStreamReader) WaitInit(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -26,11 +26,13 @@ type batcher struct {
 	closed                                        bool
 	closeChan                                     empty.Chan
 	messages                                      batcherMessagesMap
+	paused                                        map[*topicreadercommon.PartitionSession]empty.Struct
 }
 
 func newBatcher() *batcher {
 	return &batcher{
 		messages:       make(batcherMessagesMap),
+		paused:         make(map[*topicreadercommon.PartitionSession]empty.Struct),
 		closeChan:      make(empty.Chan),
 		hasNewMessages: make(empty.Chan, 1),
 	}
@@ -100,6 +102,53 @@ func (b *batcher) addNeedLock(session *topicreadercommon.PartitionSession, item
 	return nil
 }
 
+// Pause stops pop items of the session, while other sessions keep popping.
+// It returns buffer bytes of messages, which already stored for the session.
+func (b *batcher) Pause(session *topicreadercommon.PartitionSession) (bufferBytes int) {
+	b.m.WithLock(func() {
+		b.paused[session] = empty.Struct{}
+		for _, item := range b.messages[session] {
+			if item.IsBatch() {
+				bufferBytes += batchBufferBytes(item.Batch)
+			}
+		}
+	})
+
+	return bufferBytes
+}
+
+// Resume allows pop items of paused session
+func (b *batcher) Resume(session *topicreadercommon.PartitionSession) {
+	b.m.WithLock(func() {
+		delete(b.paused, session)
+		b.notifyAboutNewMessages()
+	})
+}
+
+// RemovePaused forget pause of the session after the session stopped
+func (b *batcher) RemovePaused(session *topicreadercommon.PartitionSession) {
+	b.m.WithLock(func() {
+		delete(b.paused, session)
+	})
+}
+
+func (b *batcher) IsPaused(session *topicreadercommon.PartitionSession) (paused bool) {
+	b.m.WithLock(func() {
+		paused = b.isPausedNeedLock(session)
+	})
+
+	return paused
+}
+
+func (b *batcher) isPausedNeedLock(session *topicreadercommon.PartitionSession) bool {
+	if _, ok := b.paused[session]; !ok {
+		return false
+	}
+
+	// items of stopped session must be popped for release buffer, even if session paused
+	return session.Context().Err() == nil
+}
+
 type batcherGetOptions struct {
 	MinCount        int
 	MaxCount        int
@@ -256,6 +305,10 @@ func (b *batcher) findNeedLock(filter batcherGetOptions) batcherResultCandidate
 	needBatchResult := true
 
 	for k, items := range b.messages {
+		if b.isPausedNeedLock(k) {
+			continue
+		}
+
 		head, rest, ok := rawMessageOpts.cutBatchItemsHead(items)
 		if ok {
 			return newBatcherResultCandidate(k, head, rest, true)
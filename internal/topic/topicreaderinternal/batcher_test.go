@@ -543,6 +543,65 @@ func TestBatcher_Fire(t *testing.T) {
 	})
 }
 
+func TestBatcher_Pause(t *testing.T) {
+	newSession := func(ctx context.Context, id int64) *topicreadercommon.PartitionSession {
+		return topicreadercommon.NewPartitionSession(ctx, "topic", id, 0, "", rawtopicreader.PartitionSessionID(id), id, 0)
+	}
+	newSessionBatch := func(session *topicreadercommon.PartitionSession) *topicreadercommon.PublicBatch {
+		return mustNewBatch(session, []*topicreadercommon.PublicMessage{
+			topicreadercommon.MessageWithSetCommitRangeForTest(
+				&topicreadercommon.PublicMessage{WrittenAt: testTime(int(session.PartitionID))},
+				topicreadercommon.CommitRange{PartitionSession: session},
+			),
+		})
+	}
+
+	t.Run("OtherSessionsKeepFlowing", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		session1 := newSession(ctx, 1)
+		session2 := newSession(ctx, 2)
+
+		b := newBatcher()
+		b.Pause(session1)
+		require.NoError(t, b.PushBatches(newSessionBatch(session1), newSessionBatch(session2)))
+
+		res, err := b.Pop(ctx, batcherGetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, session2, topicreadercommon.BatchGetPartitionSession(res.Batch))
+
+		popCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err = b.Pop(popCtx, batcherGetOptions{})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		popped := make(chan batcherMessageOrderItem)
+		go func() {
+			res, popErr := b.Pop(ctx, batcherGetOptions{})
+			if popErr == nil {
+				popped <- res
+			}
+		}()
+		b.Resume(session1)
+		res = <-popped
+		require.Equal(t, session1, topicreadercommon.BatchGetPartitionSession(res.Batch))
+	})
+	t.Run("StoppedSession", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		sessionCtx, sessionCancel := context.WithCancel(ctx)
+		session := newSession(sessionCtx, 1)
+
+		b := newBatcher()
+		b.Pause(session)
+		require.NoError(t, b.PushBatches(newSessionBatch(session)))
+		sessionCancel()
+
+		// items of stopped session popped for release buffer
+		res, err := b.Pop(ctx, batcherGetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, session, topicreadercommon.BatchGetPartitionSession(res.Batch))
+	})
+}
+
 func mustNewBatch(
 	session *topicreadercommon.PartitionSession,
 	messages []*topicreadercommon.PublicMessage,
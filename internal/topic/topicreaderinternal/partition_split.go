@@ -0,0 +1,143 @@
+package topicreaderinternal
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// endedPartition is partition session, which was ended by server after split/merge of the partition.
+// Children partitions of the session can't be started until all messages of the session will be
+// delivered to the user and committed.
+type endedPartition struct {
+	session           *topicreadercommon.PartitionSession
+	childPartitionIDs []int64
+	delivered         bool
+}
+
+func (p *endedPartition) isParentOf(topic string, partitionID int64) bool {
+	if p.session.Topic != topic {
+		return false
+	}
+	for _, id := range p.childPartitionIDs {
+		if id == partitionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// partitionSplits keeps order of read partitions after split/merge: parent partitions of the stream
+// must be finished before start read its children for keep order of messages with same key.
+type partitionSplits struct {
+	m             xsync.Mutex
+	ended         map[partitionSessionID]*endedPartition
+	pendingStarts []*rawtopicreader.StartPartitionSessionRequest
+}
+
+func newPartitionSplits() *partitionSplits {
+	return &partitionSplits{
+		ended: make(map[partitionSessionID]*endedPartition),
+	}
+}
+
+// AddEnded remembers ended partition session and its children.
+// It must be called from read messages loop, before start children partition sessions.
+func (s *partitionSplits) AddEnded(session *topicreadercommon.PartitionSession, childPartitionIDs []int64) {
+	s.m.WithLock(func() {
+		s.ended[session.StreamPartitionSessionID] = &endedPartition{
+			session:           session,
+			childPartitionIDs: childPartitionIDs,
+		}
+	})
+}
+
+// DeferStart stores start partition request if the partition has unfinished parents.
+// Stored requests will be returned from FinishDelivered or Finish after parents will be finished.
+func (s *partitionSplits) DeferStart(m *rawtopicreader.StartPartitionSessionRequest) (deferred bool) {
+	s.m.WithLock(func() {
+		if deferred = s.hasParentsNeedLock(m); deferred {
+			s.pendingStarts = append(s.pendingStarts, m)
+		}
+	})
+
+	return deferred
+}
+
+// SetDelivered marks the session as delivered to the user: all messages of the session was read.
+func (s *partitionSplits) SetDelivered(id partitionSessionID) {
+	s.m.WithLock(func() {
+		if p, ok := s.ended[id]; ok {
+			p.delivered = true
+		}
+	})
+}
+
+// FinishDelivered finishes delivered sessions with all received messages committed
+// and returns start requests ready to continue.
+func (s *partitionSplits) FinishDelivered(commitsEnabled bool) (ready []*rawtopicreader.StartPartitionSessionRequest) {
+	s.m.WithLock(func() {
+		for id, p := range s.ended {
+			if !p.delivered {
+				continue
+			}
+			if commitsEnabled && p.session.CommittedOffset() <= p.session.LastReceivedMessageOffset() {
+				continue
+			}
+			delete(s.ended, id)
+		}
+		ready = s.takeReadyNeedLock()
+	})
+
+	return ready
+}
+
+// Finish finishes the stopped session without wait commits, drops deferred start of the session
+// and returns start requests ready to continue.
+func (s *partitionSplits) Finish(id partitionSessionID) (ready []*rawtopicreader.StartPartitionSessionRequest) {
+	s.m.WithLock(func() {
+		delete(s.ended, id)
+		for i := range s.pendingStarts {
+			if s.pendingStarts[i].PartitionSession.PartitionSessionID == id {
+				s.pendingStarts = append(s.pendingStarts[:i], s.pendingStarts[i+1:]...)
+
+				break
+			}
+		}
+		ready = s.takeReadyNeedLock()
+	})
+
+	return ready
+}
+
+func (s *partitionSplits) takeReadyNeedLock() (ready []*rawtopicreader.StartPartitionSessionRequest) {
+	if len(s.pendingStarts) == 0 {
+		return nil
+	}
+
+	pending := s.pendingStarts[:0]
+	for _, m := range s.pendingStarts {
+		if s.hasParentsNeedLock(m) {
+			pending = append(pending, m)
+		} else {
+			ready = append(ready, m)
+		}
+	}
+	for i := len(pending); i < len(s.pendingStarts); i++ {
+		s.pendingStarts[i] = nil
+	}
+	s.pendingStarts = pending
+
+	return ready
+}
+
+func (s *partitionSplits) hasParentsNeedLock(m *rawtopicreader.StartPartitionSessionRequest) bool {
+	for _, p := range s.ended {
+		if p.isParentOf(m.PartitionSession.Path, m.PartitionSession.PartitionID) {
+			return true
+		}
+	}
+
+	return false
+}
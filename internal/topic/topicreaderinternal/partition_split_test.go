@@ -0,0 +1,196 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestPartitionSplits(t *testing.T) {
+	ctx := xtest.Context(t)
+	newSession := func(partitionID int64, sessionID partitionSessionID) *topicreadercommon.PartitionSession {
+		return topicreadercommon.NewPartitionSession(ctx, "topic", partitionID, 0, "", sessionID, 0, 10)
+	}
+	startRequest := func(topic string, partitionID int64, sessionID partitionSessionID) *rawtopicreader.StartPartitionSessionRequest { //nolint:lll
+		return &rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: sessionID,
+				Path:               topic,
+				PartitionID:        partitionID,
+			},
+		}
+	}
+
+	t.Run("StartWithoutParents", func(t *testing.T) {
+		s := newPartitionSplits()
+		s.AddEnded(newSession(1, 1), []int64{2, 3})
+		require.False(t, s.DeferStart(startRequest("topic", 4, 4)))
+		require.False(t, s.DeferStart(startRequest("other", 2, 5)))
+	})
+	t.Run("WaitCommit", func(t *testing.T) {
+		s := newPartitionSplits()
+		parent := newSession(1, 1)
+		parent.SetLastReceivedMessageOffset(20)
+		s.AddEnded(parent, []int64{2, 3})
+
+		child2 := startRequest("topic", 2, 2)
+		child3 := startRequest("topic", 3, 3)
+		require.True(t, s.DeferStart(child2))
+		require.True(t, s.DeferStart(child3))
+
+		// messages are not delivered to the user yet
+		parent.SetCommittedOffsetForward(21)
+		require.Empty(t, s.FinishDelivered(true))
+
+		s.SetDelivered(parent.StreamPartitionSessionID)
+		require.Equal(t, []*rawtopicreader.StartPartitionSessionRequest{child2, child3}, s.FinishDelivered(true))
+		require.False(t, s.DeferStart(child2))
+	})
+	t.Run("WaitNotCommitted", func(t *testing.T) {
+		s := newPartitionSplits()
+		parent := newSession(1, 1)
+		parent.SetLastReceivedMessageOffset(20)
+		s.AddEnded(parent, []int64{2})
+
+		child := startRequest("topic", 2, 2)
+		require.True(t, s.DeferStart(child))
+		s.SetDelivered(parent.StreamPartitionSessionID)
+		require.Empty(t, s.FinishDelivered(true))
+
+		parent.SetCommittedOffsetForward(21)
+		require.Equal(t, []*rawtopicreader.StartPartitionSessionRequest{child}, s.FinishDelivered(true))
+	})
+	t.Run("WithoutCommits", func(t *testing.T) {
+		s := newPartitionSplits()
+		parent := newSession(1, 1)
+		parent.SetLastReceivedMessageOffset(20)
+		s.AddEnded(parent, []int64{2})
+
+		child := startRequest("topic", 2, 2)
+		require.True(t, s.DeferStart(child))
+		s.SetDelivered(parent.StreamPartitionSessionID)
+		require.Equal(t, []*rawtopicreader.StartPartitionSessionRequest{child}, s.FinishDelivered(false))
+	})
+	t.Run("MergeWaitAllParents", func(t *testing.T) {
+		s := newPartitionSplits()
+		s.AddEnded(newSession(1, 1), []int64{3})
+		s.AddEnded(newSession(2, 2), []int64{3})
+
+		child := startRequest("topic", 3, 3)
+		require.True(t, s.DeferStart(child))
+		require.Empty(t, s.Finish(1))
+		require.Equal(t, []*rawtopicreader.StartPartitionSessionRequest{child}, s.Finish(2))
+	})
+	t.Run("StopDeferredChild", func(t *testing.T) {
+		s := newPartitionSplits()
+		s.AddEnded(newSession(1, 1), []int64{2})
+
+		require.True(t, s.DeferStart(startRequest("topic", 2, 2)))
+		require.Empty(t, s.Finish(2))
+		require.Empty(t, s.Finish(1))
+	})
+}
+
+func TestTopicStreamReaderImpl_PartitionSplit(t *testing.T) {
+	xtest.TestManyTimes(t, func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+
+		lastOffset := e.partitionSession.LastReceivedMessageOffset()
+		const dataSize = 4
+		const childPartitionID = 6
+		const childSessionID = partitionSessionID(16)
+
+		e.stream.EXPECT().Send(&rawtopicreader.ReadRequest{BytesSize: dataSize}).AnyTimes()
+
+		e.SendFromServer(&rawtopicreader.ReadResponse{
+			BytesSize: dataSize,
+			PartitionData: []rawtopicreader.PartitionData{
+				{
+					PartitionSessionID: e.partitionSessionID,
+					Batches: []rawtopicreader.Batch{
+						{
+							Codec:       rawtopiccommon.CodecRaw,
+							ProducerID:  "1",
+							MessageData: []rawtopicreader.MessageData{{Offset: lastOffset + 1}},
+						},
+					},
+				},
+			},
+		})
+		e.SendFromServer(&rawtopicreader.EndPartitionSession{
+			PartitionSessionID: e.partitionSessionID,
+			ChildPartitionIDs:  []int64{childPartitionID},
+		})
+		e.SendFromServer(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: childSessionID,
+				Path:               e.partitionSession.Topic,
+				PartitionID:        childPartitionID,
+			},
+		})
+
+		batch, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+		require.NoError(t, err)
+		require.Same(t, e.partitionSession, topicreadercommon.BatchGetPartitionSession(batch))
+
+		// handle end of the parent and start of the child in background
+		readCtx, readCancel := xcontext.WithCancel(e.ctx)
+		readDone := make(empty.Chan)
+		go func() {
+			defer close(readDone)
+			_, _ = e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+		}()
+		defer func() {
+			readCancel()
+			xtest.WaitChannelClosed(t, readDone)
+		}()
+
+		// child partition mustn't start before commit of the parent messages
+		xtest.SpinWaitCondition(t, &e.reader.splits.m, func() bool {
+			return len(e.reader.splits.pendingStarts) == 1 && e.reader.splits.ended[e.partitionSessionID].delivered
+		})
+
+		commitReceived := make(empty.Chan)
+		e.stream.EXPECT().Send(&rawtopicreader.CommitOffsetRequest{
+			CommitOffsets: []rawtopicreader.PartitionCommitOffset{
+				{
+					PartitionSessionID: e.partitionSessionID,
+					Offsets:            []rawtopiccommon.OffsetRange{{Start: lastOffset + 1, End: lastOffset + 2}},
+				},
+			},
+		}).DoAndReturn(func(_ rawtopicreader.ClientMessage) error {
+			close(commitReceived)
+
+			return nil
+		})
+
+		childStarted := make(empty.Chan)
+		e.stream.EXPECT().Send(gomock.AssignableToTypeOf(&rawtopicreader.StartPartitionSessionResponse{})).
+			DoAndReturn(func(msg rawtopicreader.ClientMessage) error {
+				require.Equal(t, childSessionID, msg.(*rawtopicreader.StartPartitionSessionResponse).PartitionSessionID) //nolint:forcetypeassert,lll
+				close(childStarted)
+
+				return nil
+			})
+
+		require.NoError(t, e.reader.Commit(context.Background(), topicreadercommon.GetCommitRange(batch)))
+		xtest.WaitChannelClosed(t, commitReceived)
+
+		e.SendFromServer(&rawtopicreader.CommitOffsetResponse{
+			PartitionsCommittedOffsets: []rawtopicreader.PartitionCommittedOffset{
+				{PartitionSessionID: e.partitionSessionID, CommittedOffset: lastOffset + 2},
+			},
+		})
+		xtest.WaitChannelClosed(t, childStarted)
+	})
+}
@@ -0,0 +1,57 @@
+package topicreaderinternal
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+)
+
+// pausedBuffer accounts buffer bytes of paused partition sessions.
+//
+// Messages of paused session can't be popped from the batcher, then they can't free space in the read buffer
+// and stall all partitions of the stream. For prevent it - buffer bytes of paused session returned to the server
+// without wait pop the messages. Bytes returned once: pop of the messages after resume doesn't return them again.
+//
+// Returned bytes limited by the limit, messages above the limit hold space of read buffer as usual.
+// Methods of pausedBuffer are not thread safe.
+type pausedBuffer struct {
+	limit    int
+	total    int
+	released map[*topicreadercommon.PartitionSession]int
+}
+
+func newPausedBuffer(limit int) pausedBuffer {
+	return pausedBuffer{
+		limit:    limit,
+		released: make(map[*topicreadercommon.PartitionSession]int),
+	}
+}
+
+// Release marks bytes of paused session messages as returned to the server.
+// It returns bytes count for return.
+func (b *pausedBuffer) Release(session *topicreadercommon.PartitionSession, bytes int) int {
+	bytes = min(bytes, b.limit-b.total)
+	if bytes <= 0 {
+		return 0
+	}
+
+	b.total += bytes
+	b.released[session] += bytes
+
+	return bytes
+}
+
+// Pop returns bytes count of popped messages, which must be returned to the server
+func (b *pausedBuffer) Pop(session *topicreadercommon.PartitionSession, bytes int) int {
+	released, ok := b.released[session]
+	if !ok {
+		return bytes
+	}
+
+	released = min(released, bytes)
+	b.total -= released
+	b.released[session] -= released
+	if b.released[session] == 0 {
+		delete(b.released, session)
+	}
+
+	return bytes - released
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
@@ -182,6 +183,26 @@ func (r *Reader) getBatchOptions(opts []PublicReadBatchOption) ReadMessageBatchO
 	return readOptions
 }
 
+// Seek moves read position of the partition session to the offset
+func (r *Reader) Seek(ctx context.Context, partitionSessionID, offset int64) error {
+	return r.reader.Seek(ctx, partitionSessionID, offset)
+}
+
+// SeekToTime skips messages written before readFrom for all partitions of the reader
+func (r *Reader) SeekToTime(ctx context.Context, readFrom time.Time) error {
+	return r.reader.SeekToTime(ctx, readFrom)
+}
+
+// Pause stops receive messages of the partition session
+func (r *Reader) Pause(ctx context.Context, partitionSessionID int64) error {
+	return r.reader.Pause(ctx, partitionSessionID)
+}
+
+// Resume continues receive messages of paused partition session
+func (r *Reader) Resume(ctx context.Context, partitionSessionID int64) error {
+	return r.reader.Resume(ctx, partitionSessionID)
+}
+
 func (r *Reader) Commit(ctx context.Context, offsets topicreadercommon.PublicCommitRangeGetter) (err error) {
 	cr := topicreadercommon.GetCommitRange(offsets)
 	if cr.PartitionSession.ReaderID != r.readerID {
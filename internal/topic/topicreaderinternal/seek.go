@@ -0,0 +1,126 @@
+package topicreaderinternal
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+type seekPartition struct {
+	topic       string
+	partitionID int64
+}
+
+// seekState is shared between all streams of the reader.
+// Seek requests stored in the state and applied by next stream after reconnect,
+// because protocol has no way to move read position of started partition session.
+// Paused partitions stored in the state too, for keep them paused on partition sessions of next streams.
+type seekState struct {
+	m        xsync.Mutex
+	offsets  map[seekPartition]rawtopiccommon.Offset
+	readFrom time.Time
+
+	paused         map[seekPartition]empty.Struct
+	pausedSessions map[int64]seekPartition // client partition session id -> paused partition
+}
+
+func newSeekState() *seekState {
+	return &seekState{}
+}
+
+func (s *seekState) SetOffset(topic string, partitionID int64, offset rawtopiccommon.Offset) {
+	s.m.WithLock(func() {
+		if s.offsets == nil {
+			s.offsets = make(map[seekPartition]rawtopiccommon.Offset)
+		}
+		s.offsets[seekPartition{topic: topic, partitionID: partitionID}] = offset
+	})
+}
+
+// SetReadFrom set read from filter for all partitions of the reader.
+// Previous seeks to offsets are dropped, because server starts read from max of read offset and read from time.
+func (s *seekState) SetReadFrom(readFrom time.Time) {
+	s.m.WithLock(func() {
+		s.readFrom = readFrom
+		s.offsets = nil
+	})
+}
+
+// TakeOffsets returns offsets for partitions of new stream and clear them in the state.
+// Offsets apply once: if partition will not started on the stream - seek to the partition ignored.
+func (s *seekState) TakeOffsets() (offsets map[seekPartition]rawtopiccommon.Offset) {
+	s.m.WithLock(func() {
+		offsets = s.offsets
+		s.offsets = nil
+	})
+
+	return offsets
+}
+
+// ApplyReadFrom returns read selectors with read from time of the last seek
+func (s *seekState) ApplyReadFrom(
+	selectors []*topicreadercommon.PublicReadSelector,
+) []*topicreadercommon.PublicReadSelector {
+	var readFrom time.Time
+	s.m.WithLock(func() {
+		readFrom = s.readFrom
+	})
+	if readFrom.IsZero() {
+		return selectors
+	}
+
+	res := make([]*topicreadercommon.PublicReadSelector, len(selectors))
+	for i := range selectors {
+		res[i] = selectors[i].Clone()
+		res[i].ReadFrom = readFrom
+	}
+
+	return res
+}
+
+// SetPaused marks partition as paused and remember the partition session id for resume
+func (s *seekState) SetPaused(topic string, partitionID int64, partitionSessionID int64) {
+	key := seekPartition{topic: topic, partitionID: partitionID}
+	s.m.WithLock(func() {
+		if s.paused == nil {
+			s.paused = make(map[seekPartition]empty.Struct)
+			s.pausedSessions = make(map[int64]seekPartition)
+		}
+		s.paused[key] = empty.Struct{}
+		s.pausedSessions[partitionSessionID] = key
+	})
+}
+
+// AddSessionIfPaused remember new partition session id if the partition paused
+func (s *seekState) AddSessionIfPaused(topic string, partitionID int64, partitionSessionID int64) (paused bool) {
+	key := seekPartition{topic: topic, partitionID: partitionID}
+	s.m.WithLock(func() {
+		if _, paused = s.paused[key]; paused {
+			s.pausedSessions[partitionSessionID] = key
+		}
+	})
+
+	return paused
+}
+
+// Resume removes pause of partition by any partition session id, which was given for the paused partition.
+// It returns ok=false if the partition session id unknown.
+func (s *seekState) Resume(partitionSessionID int64) (_ seekPartition, ok bool) {
+	var key seekPartition
+	s.m.WithLock(func() {
+		if key, ok = s.pausedSessions[partitionSessionID]; !ok {
+			return
+		}
+		delete(s.paused, key)
+		for id, sessionKey := range s.pausedSessions {
+			if sessionKey == key {
+				delete(s.pausedSessions, id)
+			}
+		}
+	})
+
+	return key, ok
+}
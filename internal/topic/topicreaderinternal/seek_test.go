@@ -0,0 +1,294 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestSeekState(t *testing.T) {
+	t.Run("OffsetsAppliedOnce", func(t *testing.T) {
+		s := newSeekState()
+		s.SetOffset("topic", 1, 10)
+		s.SetOffset("topic", 1, 20)
+		s.SetOffset("topic", 2, 30)
+
+		require.Equal(t, map[seekPartition]rawtopiccommon.Offset{
+			{topic: "topic", partitionID: 1}: 20,
+			{topic: "topic", partitionID: 2}: 30,
+		}, s.TakeOffsets())
+		require.Empty(t, s.TakeOffsets())
+	})
+	t.Run("ReadFrom", func(t *testing.T) {
+		s := newSeekState()
+		selectors := []*topicreadercommon.PublicReadSelector{{Path: "topic", Partitions: []int64{1}}}
+		require.Equal(t, selectors, s.ApplyReadFrom(selectors))
+
+		readFrom := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+		s.SetOffset("topic", 1, 10)
+		s.SetReadFrom(readFrom)
+		require.Empty(t, s.TakeOffsets())
+
+		require.Equal(t, []*topicreadercommon.PublicReadSelector{
+			{Path: "topic", Partitions: []int64{1}, ReadFrom: readFrom},
+		}, s.ApplyReadFrom(selectors))
+		require.True(t, selectors[0].ReadFrom.IsZero(), "source selectors must not be changed")
+	})
+	t.Run("Paused", func(t *testing.T) {
+		s := newSeekState()
+		require.False(t, s.AddSessionIfPaused("topic", 1, 10))
+
+		s.SetPaused("topic", 1, 10)
+		require.True(t, s.AddSessionIfPaused("topic", 1, 11))
+		require.False(t, s.AddSessionIfPaused("topic", 2, 12))
+
+		// resume by session id of previous stream
+		key, ok := s.Resume(10)
+		require.True(t, ok)
+		require.Equal(t, seekPartition{topic: "topic", partitionID: 1}, key)
+		require.False(t, s.AddSessionIfPaused("topic", 1, 13))
+
+		_, ok = s.Resume(11)
+		require.False(t, ok)
+	})
+}
+
+func TestTopicStreamReaderImpl_Seek(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+
+		require.NoError(t, e.reader.Seek(e.ctx, e.partitionSession.ClientPartitionSessionID, 30))
+		require.True(t, e.reader.closed)
+		require.ErrorIs(t, e.reader.err, errSeek)
+		require.Equal(t, map[seekPartition]rawtopiccommon.Offset{
+			{topic: e.partitionSession.Topic, partitionID: e.partitionSession.PartitionID}: 30,
+		}, e.reader.cfg.seeks.TakeOffsets())
+
+		// stream closed, seek must be retried on next stream
+		err := e.reader.Seek(e.ctx, e.partitionSession.ClientPartitionSessionID, 40)
+		require.ErrorIs(t, err, errSeek)
+		require.Empty(t, e.reader.cfg.seeks.TakeOffsets())
+	})
+	t.Run("BeforeCommittedOffset", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+
+		offset := e.partitionSession.CommittedOffset().ToInt64() - 1
+		err := e.reader.Seek(e.ctx, e.partitionSession.ClientPartitionSessionID, offset)
+		require.ErrorIs(t, err, errSeekBeforeCommittedOffset)
+		require.False(t, e.reader.closed)
+	})
+	t.Run("UnknownSession", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+
+		err := e.reader.Seek(e.ctx, e.partitionSession.ClientPartitionSessionID+1, 30)
+		require.ErrorIs(t, err, topicreadercommon.PublicErrPartitionSessionExpired)
+		require.ErrorIs(t, e.reader.Pause(e.ctx, 0), topicreadercommon.PublicErrPartitionSessionExpired)
+		require.False(t, e.reader.closed)
+	})
+	t.Run("SeekToTime", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+
+		readFrom := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, e.reader.SeekToTime(e.ctx, readFrom))
+		require.True(t, e.reader.closed)
+		require.ErrorIs(t, e.reader.err, errSeek)
+		require.Equal(t, readFrom, e.reader.cfg.seeks.readFrom)
+	})
+	t.Run("ApplyOnStartPartition", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.reader.seekOffsets = map[seekPartition]rawtopiccommon.Offset{
+			{topic: e.partitionSession.Topic, partitionID: e.partitionSession.PartitionID}: 30,
+		}
+
+		e.stream.EXPECT().Send(&rawtopicreader.StartPartitionSessionResponse{
+			PartitionSessionID: e.partitionSessionID,
+			ReadOffset:         rawtopicreader.OptionalOffset{Offset: 30, HasValue: true},
+			CommitOffset:       rawtopicreader.OptionalOffset{Offset: 30, HasValue: true},
+		}).Return(nil)
+
+		err := e.reader.onStartPartitionSessionRequestFromBuffer(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: e.partitionSessionID,
+				Path:               e.partitionSession.Topic,
+				PartitionID:        e.partitionSession.PartitionID,
+			},
+		})
+		require.NoError(t, err)
+		require.Empty(t, e.reader.seekOffsets)
+		require.Equal(t, rawtopiccommon.Offset(30), e.partitionSession.CommittedOffset())
+		require.Equal(t, rawtopiccommon.Offset(29), e.partitionSession.LastReceivedMessageOffset())
+	})
+}
+
+func TestTopicReaderReconnectorSeek(t *testing.T) {
+	mc := gomock.NewController(t)
+	ctx := xtest.Context(t)
+
+	baseReader := NewMockbatchedStreamReader(mc)
+	baseReader.EXPECT().Seek(gomock.Any(), int64(1), int64(10)).Return(nil)
+	baseReader.EXPECT().Pause(gomock.Any(), int64(1)).Return(nil)
+
+	reader := &readerReconnector{
+		streamVal:           baseReader,
+		streamContextCancel: func(cause error) {},
+		tracer:              &trace.Topic{},
+	}
+	reader.initChannelsAndClock()
+
+	require.NoError(t, reader.Pause(ctx, 1))
+	require.Empty(t, reader.reconnectFromBadStream)
+
+	require.NoError(t, reader.Seek(ctx, 1, 10))
+	select {
+	case req := <-reader.reconnectFromBadStream:
+		require.Equal(t, baseReader, req.oldReader)
+		require.ErrorIs(t, req.reason, errSeek)
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+}
+
+func TestTopicStreamReaderImpl_Pause(t *testing.T) {
+	readResponse := func(
+		session *topicreadercommon.PartitionSession,
+		offset rawtopiccommon.Offset,
+		bytesSize int,
+	) *rawtopicreader.ReadResponse {
+		return &rawtopicreader.ReadResponse{
+			BytesSize: bytesSize,
+			PartitionData: []rawtopicreader.PartitionData{
+				{
+					PartitionSessionID: session.StreamPartitionSessionID,
+					Batches: []rawtopicreader.Batch{
+						{
+							MessageData: []rawtopicreader.MessageData{
+								{Offset: offset, SeqNo: int64(offset)},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("PausedPartitionDoesNotStallOthers", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		otherSession := topicreadercommon.NewPartitionSession(
+			e.ctx, "/test", 6, e.reader.readerID, e.reader.readConnectionID, 16, 116, 0,
+		)
+		require.NoError(t, e.reader.sessionController.Add(otherSession))
+		e.Start()
+
+		expectDataRequest := func(bytesSize int) empty.Chan {
+			sent := make(empty.Chan)
+			e.stream.EXPECT().Send(&rawtopicreader.ReadRequest{BytesSize: bytesSize}).DoAndReturn(func(_ rawtopicreader.ClientMessage) error {
+				close(sent)
+
+				return nil
+			})
+
+			return sent
+		}
+
+		require.NoError(t, e.reader.Pause(e.ctx, e.partitionSession.ClientPartitionSessionID))
+
+		// paused partition receives more than the reader buffer size
+		bufferSize := int(e.initialBufferSizeBytes)
+		pausedMessageSize := bufferSize * 6 / 10
+		sent := expectDataRequest(pausedMessageSize)
+		e.SendFromServer(readResponse(e.partitionSession, 21, pausedMessageSize))
+		xtest.WaitChannelClosed(t, sent)
+
+		// budget for paused partitions limited by buffer size
+		sent = expectDataRequest(bufferSize - pausedMessageSize)
+		e.SendFromServer(readResponse(e.partitionSession, 22, pausedMessageSize))
+		xtest.WaitChannelClosed(t, sent)
+
+		// other partition keep delivering
+		otherMessageSize := bufferSize / 2
+		sent = expectDataRequest(otherMessageSize)
+		e.SendFromServer(readResponse(otherSession, 1, otherMessageSize))
+		batch, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+		require.NoError(t, err)
+		require.Equal(t, otherSession, topicreadercommon.BatchGetPartitionSession(batch))
+		xtest.WaitChannelClosed(t, sent)
+
+		// after resume only bytes above the paused limit returned to the server
+		require.NoError(t, e.reader.Resume(e.ctx, e.partitionSession.ClientPartitionSessionID))
+		sent = expectDataRequest(2*pausedMessageSize - bufferSize)
+		readMessages := 0
+		for readMessages < 2 {
+			batch, err = e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+			require.NoError(t, err)
+			require.Equal(t, e.partitionSession, topicreadercommon.BatchGetPartitionSession(batch))
+			readMessages += len(batch.Messages)
+		}
+		xtest.WaitChannelClosed(t, sent)
+
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return e.reader.restBufferSizeBytes.Load() == e.initialBufferSizeBytes
+		})
+	})
+	t.Run("KeepPausedAfterReconnect", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+		oldSessionID := e.partitionSession.ClientPartitionSessionID
+		require.NoError(t, e.reader.Pause(e.ctx, oldSessionID))
+
+		// next stream of the reader
+		e2 := newTopicReaderTestEnv(t)
+		e2.reader.cfg.seeks = e.reader.cfg.seeks
+		require.NoError(t, e2.reader.onStartPartitionSessionRequest(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: 25,
+				Path:               e.partitionSession.Topic,
+				PartitionID:        e.partitionSession.PartitionID,
+			},
+		}))
+		newSession, err := e2.reader.sessionController.Get(25)
+		require.NoError(t, err)
+		require.True(t, e2.reader.batcher.IsPaused(newSession))
+
+		require.NoError(t, e2.reader.Resume(e2.ctx, oldSessionID))
+		require.False(t, e2.reader.batcher.IsPaused(newSession))
+	})
+	t.Run("ForgetPauseOfStoppedSession", func(t *testing.T) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+		require.NoError(t, e.reader.Pause(e.ctx, e.partitionSession.ClientPartitionSessionID))
+
+		e.SendFromServer(&rawtopicreader.StopPartitionSessionRequest{
+			PartitionSessionID: e.partitionSessionID,
+		})
+
+		// stop message processed while read messages
+		readCtx, cancel := context.WithCancel(e.ctx)
+		go func() {
+			xtest.SpinWaitCondition(t, nil, func() bool {
+				var pausedCount int
+				e.reader.batcher.m.WithLock(func() {
+					pausedCount = len(e.reader.batcher.paused)
+				})
+
+				return pausedCount == 0
+			})
+			cancel()
+		}()
+		_, _ = e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+		<-readCtx.Done()
+	})
+}
@@ -34,6 +34,8 @@ var (
 	errCantCommitWithoutConsumer     = xerrors.Wrap(errors.New("ydb: reader can't commit messages without consumer"))
 	errBufferSize                    = xerrors.Wrap(errors.New("ydb: buffer of topic reader must be greater than zero, see option topicoptions.WithReaderBufferSizeBytes")) //nolint:lll
 	errTopicSelectorsEmpty           = xerrors.Wrap(errors.New("ydb: topic selector for topic reader is empty, see arguments on topic starts"))                             //nolint:lll
+	errSeekBeforeCommittedOffset     = xerrors.Wrap(errors.New("ydb: seek before committed offset allowed for reader without consumer only"))                               //nolint:lll
+	errSeek                          = xerrors.Retryable(xerrors.Wrap(errors.New("ydb: reconnect topic reader stream for apply seek")))                                     //nolint:lll
 )
 
 var clientSessionCounter atomic.Int64
@@ -56,6 +58,12 @@ type topicStreamReaderImpl struct {
 	batcher   *batcher
 	committer *topicreadercommon.Committer
 
+	pauseM       xsync.Mutex // guard pause/resume sessions in the batcher together with pausedBuffer accounting
+	pausedBuffer pausedBuffer
+
+	seekOffsets map[seekPartition]rawtopiccommon.Offset // access from consumeRawMessageFromBuffer only
+	splits      *partitionSplits
+
 	stream           topicreadercommon.RawTopicReaderStream
 	readConnectionID string
 	readerID         int64
@@ -80,6 +88,9 @@ type topicStreamReaderConfig struct {
 	GetPartitionStartOffsetCallback PublicGetPartitionStartOffsetFunc
 	CommitMode                      topicreadercommon.PublicCommitMode
 	Decoders                        topicreadercommon.DecoderMap
+	SupportSplitMergePartitions     bool
+
+	seeks *seekState
 }
 
 func newTopicStreamReaderConfig() topicStreamReaderConfig {
@@ -92,6 +103,9 @@ func newTopicStreamReaderConfig() topicStreamReaderConfig {
 		CommitterBatchTimeLag: time.Second,
 		Decoders:              topicreadercommon.NewDecoderMap(),
 		Trace:                 &trace.Topic{},
+		seeks:                 newSeekState(),
+
+		SupportSplitMergePartitions: true,
 	}
 }
 
@@ -149,6 +163,10 @@ func newTopicStreamReaderStopped(
 	labeledContext := pprof.WithLabels(cfg.BaseContext, pprof.Labels("base-context", "topic-stream-reader"))
 	stopPump, cancel := xcontext.WithCancel(labeledContext)
 
+	if cfg.seeks == nil {
+		cfg.seeks = newSeekState()
+	}
+
 	readerConnectionID, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
 	if err != nil {
 		readerConnectionID = big.NewInt(-1)
@@ -159,12 +177,15 @@ func newTopicStreamReaderStopped(
 		ctx:                   stopPump,
 		topicClient:           client,
 		freeBytes:             make(chan int, 1),
+		pausedBuffer:          newPausedBuffer(cfg.BufferSizeProtoBytes),
 		stream:                topicreadercommon.NewSyncedStream(stream),
 		cancel:                cancel,
 		batcher:               newBatcher(),
 		readConnectionID:      "preinitID-" + readerConnectionID.String(),
 		readerID:              readerID,
 		rawMessagesFromBuffer: make(chan rawtopicreader.ServerMessage, 1),
+		seekOffsets:           cfg.seeks.TakeOffsets(),
+		splits:                newPartitionSplits(),
 	}
 
 	res.backgroundWorkers = *background.NewWorker(stopPump, "topic-reader-stream-background")
@@ -445,6 +466,8 @@ func (r *topicStreamReaderImpl) consumeRawMessageFromBuffer(ctx context.Context)
 
 				return
 			}
+		case *rawtopicreader.EndPartitionSession:
+			r.onEndPartitionSessionFromBuffer(m)
 		case *rawtopicreader.PartitionSessionStatusResponse:
 			r.onPartitionSessionStatusResponseFromBuffer(ctx, m)
 		default:
@@ -487,6 +510,12 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 		}
 	}
 
+	r.pauseM.WithLock(func() {
+		r.batcher.RemovePaused(session)
+	})
+
+	r.continueStartPartitionSessions(r.splits.Finish(session.StreamPartitionSessionID))
+
 	if _, err = r.sessionController.Remove(session.StreamPartitionSessionID); err != nil {
 		if msg.Graceful {
 			return err
@@ -501,6 +530,20 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 	return nil
 }
 
+// onEndPartitionSessionFromBuffer called after all messages of the ended session was read by the user,
+// children partitions will start after commit the messages.
+func (r *topicStreamReaderImpl) onEndPartitionSessionFromBuffer(m *rawtopicreader.EndPartitionSession) {
+	r.splits.SetDelivered(m.PartitionSessionID)
+	r.continueStartPartitionSessions(r.splits.FinishDelivered(r.cfg.CommitMode.CommitsEnabled()))
+}
+
+// continueStartPartitionSessions sends deferred start partition requests to handle them again
+func (r *topicStreamReaderImpl) continueStartPartitionSessions(ready []*rawtopicreader.StartPartitionSessionRequest) {
+	for _, m := range ready {
+		r.sendRawMessageToChannelUnblocked(m)
+	}
+}
+
 func (r *topicStreamReaderImpl) onPartitionSessionStatusResponseFromBuffer(
 	ctx context.Context,
 	m *rawtopicreader.PartitionSessionStatusResponse,
@@ -508,6 +551,108 @@ func (r *topicStreamReaderImpl) onPartitionSessionStatusResponseFromBuffer(
 	panic("not implemented")
 }
 
+// Seek stores offset for the partition and closes the stream.
+// The offset will be applied to the partition session on next stream.
+func (r *topicStreamReaderImpl) Seek(ctx context.Context, partitionSessionID, offset int64) error {
+	session, err := r.getPartitionSession(partitionSessionID)
+	if err != nil {
+		return err
+	}
+
+	if r.cfg.CommitMode.CommitsEnabled() && offset < session.CommittedOffset().ToInt64() {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: seek to offset %v, committed offset %v: %w",
+			offset, session.CommittedOffset().ToInt64(), errSeekBeforeCommittedOffset,
+		))
+	}
+
+	var seekOffset rawtopiccommon.Offset
+	seekOffset.FromInt64(offset)
+
+	return r.closeForSeek(ctx, func() {
+		r.cfg.seeks.SetOffset(session.Topic, session.PartitionID, seekOffset)
+	})
+}
+
+// SeekToTime stores read from time for all partitions and closes the stream.
+func (r *topicStreamReaderImpl) SeekToTime(ctx context.Context, readFrom time.Time) error {
+	return r.closeForSeek(ctx, func() {
+		r.cfg.seeks.SetReadFrom(readFrom)
+	})
+}
+
+func (r *topicStreamReaderImpl) closeForSeek(ctx context.Context, setSeek func()) error {
+	var closeReason error
+	r.m.WithLock(func() {
+		closeReason = r.err
+		if !r.closed {
+			// the seek is stored before close, then next stream will see it
+			setSeek()
+		}
+	})
+	if closeReason != nil {
+		return xerrors.WithStackTrace(closeReason)
+	}
+
+	// errors of stream close are not related to the seek, the stream will be reconnected anyway
+	_ = r.CloseWithError(xcontext.ValueOnly(ctx), xerrors.WithStackTrace(errSeek))
+
+	return nil
+}
+
+// Pause stores pause of the partition in the reader state, then the partition keep paused after reconnect.
+func (r *topicStreamReaderImpl) Pause(_ context.Context, partitionSessionID int64) error {
+	session, err := r.getPartitionSession(partitionSessionID)
+	if err != nil {
+		return err
+	}
+
+	var freeBytes int
+	r.pauseM.WithLock(func() {
+		r.cfg.seeks.SetPaused(session.Topic, session.PartitionID, partitionSessionID)
+		freeBytes = r.pausedBuffer.Release(session, r.batcher.Pause(session))
+	})
+	r.freeBuffer(freeBytes)
+
+	return nil
+}
+
+// Resume accepts id of partition session from any previous stream of the reader, if the partition was paused by it.
+func (r *topicStreamReaderImpl) Resume(_ context.Context, partitionSessionID int64) error {
+	r.pauseM.Lock()
+	defer r.pauseM.Unlock()
+
+	key, ok := r.cfg.seeks.Resume(partitionSessionID)
+	if !ok {
+		session, err := r.getPartitionSession(partitionSessionID)
+		if err != nil {
+			return err
+		}
+		key = seekPartition{topic: session.Topic, partitionID: session.PartitionID}
+	}
+
+	for _, session := range r.sessionController.GetAll() {
+		if session.Topic == key.topic && session.PartitionID == key.partitionID {
+			r.batcher.Resume(session)
+		}
+	}
+
+	return nil
+}
+
+func (r *topicStreamReaderImpl) getPartitionSession(
+	partitionSessionID int64,
+) (*topicreadercommon.PartitionSession, error) {
+	for _, session := range r.sessionController.GetAll() {
+		if session.ClientPartitionSessionID == partitionSessionID && session.Context().Err() == nil {
+			return session, nil
+		}
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: partition session id %v: %w",
+		partitionSessionID, topicreadercommon.PublicErrPartitionSessionExpired,
+	))
+}
+
 func (r *topicStreamReaderImpl) onUpdateTokenResponse(m *rawtopicreader.UpdateTokenResponse) {
 }
 
@@ -611,7 +756,8 @@ func (r *topicStreamReaderImpl) setStarted() error {
 }
 
 func (r *topicStreamReaderImpl) initSession() (err error) {
-	initMessage := topicreadercommon.CreateInitMessage(r.cfg.Consumer, r.cfg.ReadSelectors)
+	initMessage := topicreadercommon.CreateInitMessage(r.cfg.Consumer, r.cfg.seeks.ApplyReadFrom(r.cfg.ReadSelectors))
+	initMessage.AutoPartitioningSupport = r.cfg.SupportSplitMergePartitions
 
 	onDone := trace.TopicOnReaderInit(r.cfg.Trace, r.readConnectionID, initMessage)
 	defer func() {
@@ -700,6 +846,12 @@ func (r *topicStreamReaderImpl) readMessagesLoop(ctx context.Context) {
 			if err = r.onStopPartitionSessionRequest(m); err != nil {
 				_ = r.CloseWithError(ctx, err)
 
+				return
+			}
+		case *rawtopicreader.EndPartitionSession:
+			if err = r.onEndPartitionSession(m); err != nil {
+				_ = r.CloseWithError(ctx, err)
+
 				return
 			}
 		case *rawtopicreader.CommitOffsetResponse:
@@ -766,16 +918,33 @@ func (r *topicStreamReaderImpl) sendDataRequest(size int) error {
 }
 
 func (r *topicStreamReaderImpl) freeBufferFromMessages(batch *topicreadercommon.PublicBatch) {
-	size := 0
-	for messageIndex := range batch.Messages {
-		size += topicreadercommon.MessageGetBufferBytesAccount(batch.Messages[messageIndex])
+	size := batchBufferBytes(batch)
+	r.pauseM.WithLock(func() {
+		size = r.pausedBuffer.Pop(topicreadercommon.BatchGetPartitionSession(batch), size)
+	})
+	r.freeBuffer(size)
+}
+
+func (r *topicStreamReaderImpl) freeBuffer(size int) {
+	if size == 0 {
+		return
 	}
+
 	select {
 	case r.freeBytes <- size:
 	case <-r.ctx.Done():
 	}
 }
 
+func batchBufferBytes(batch *topicreadercommon.PublicBatch) int {
+	size := 0
+	for messageIndex := range batch.Messages {
+		size += topicreadercommon.MessageGetBufferBytesAccount(batch.Messages[messageIndex])
+	}
+
+	return size
+}
+
 func (r *topicStreamReaderImpl) updateTokenLoop(ctx context.Context) {
 	ticker := time.NewTicker(r.cfg.CredUpdateInterval)
 	defer ticker.Stop()
@@ -804,9 +973,20 @@ func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse)
 	}
 
 	for i := range batches {
-		if err := r.batcher.PushBatches(batches[i]); err != nil {
+		// messages of paused session don't hold read buffer, see pausedBuffer
+		var freeBytes int
+		r.pauseM.WithLock(func() {
+			if err = r.batcher.PushBatches(batches[i]); err != nil {
+				return
+			}
+			if session := topicreadercommon.BatchGetPartitionSession(batches[i]); r.batcher.IsPaused(session) {
+				freeBytes = r.pausedBuffer.Release(session, batchBufferBytes(batches[i]))
+			}
+		})
+		if err != nil {
 			return err
 		}
+		r.freeBuffer(freeBytes)
 	}
 
 	return nil
@@ -874,6 +1054,8 @@ func (r *topicStreamReaderImpl) onCommitResponse(msg *rawtopicreader.CommitOffse
 		r.committer.OnCommitNotify(partition, commit.CommittedOffset)
 	}
 
+	r.continueStartPartitionSessions(r.splits.FinishDelivered(r.cfg.CommitMode.CommitsEnabled()))
+
 	return nil
 }
 
@@ -907,12 +1089,23 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequest(m *rawtopicreader
 		return err
 	}
 
+	r.pauseM.WithLock(func() {
+		if r.cfg.seeks.AddSessionIfPaused(session.Topic, session.PartitionID, session.ClientPartitionSessionID) {
+			r.batcher.Pause(session)
+		}
+	})
+
 	return r.batcher.PushRawMessage(session, m)
 }
 
 func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 	m *rawtopicreader.StartPartitionSessionRequest,
 ) (err error) {
+	if r.splits.DeferStart(m) {
+		// the partition will be started after finish read its parents
+		return nil
+	}
+
 	session, err := r.sessionController.Get(m.PartitionSession.PartitionSessionID)
 	if err != nil {
 		return err
@@ -956,6 +1149,18 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		}
 	}
 
+	seekKey := seekPartition{topic: session.Topic, partitionID: session.PartitionID}
+	if seekOffset, ok := r.seekOffsets[seekKey]; ok {
+		delete(r.seekOffsets, seekKey)
+		wantOffset := seekOffset.ToInt64()
+		forceOffset = &wantOffset
+	}
+	if forceOffset != nil {
+		var offset rawtopiccommon.Offset
+		offset.FromInt64(*forceOffset)
+		session.SetReadOffset(offset, r.cfg.CommitMode.CommitsEnabled())
+	}
+
 	respMessage.ReadOffset.FromInt64Pointer(forceOffset)
 	if r.cfg.CommitMode.CommitsEnabled() {
 		commitOffset = forceOffset
@@ -977,3 +1182,14 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequest(m *rawtopicreader.
 
 	return r.batcher.PushRawMessage(session, m)
 }
+
+func (r *topicStreamReaderImpl) onEndPartitionSession(m *rawtopicreader.EndPartitionSession) error {
+	session, err := r.sessionController.Get(m.PartitionSessionID)
+	if err != nil {
+		return err
+	}
+
+	r.splits.AddEnded(session, m.ChildPartitionIDs)
+
+	return r.batcher.PushRawMessage(session, m)
+}
@@ -121,6 +121,63 @@ func (r *readerReconnector) ReadMessageBatch(
 	)
 }
 
+// Seek moves read position of the partition. Seek restarts the stream,
+// because the offset can be applied on start of partition session only.
+func (r *readerReconnector) Seek(ctx context.Context, partitionSessionID, offset int64) error {
+	return r.controlWithReconnections(ctx, func(ctx context.Context, stream batchedStreamReader) error {
+		if err := stream.Seek(ctx, partitionSessionID, offset); err != nil {
+			return err
+		}
+		r.fireReconnectOnRetryableError(stream, xerrors.WithStackTrace(errSeek))
+
+		return nil
+	})
+}
+
+// SeekToTime skips messages written before readFrom for all partitions. Seek restarts the stream.
+func (r *readerReconnector) SeekToTime(ctx context.Context, readFrom time.Time) error {
+	return r.controlWithReconnections(ctx, func(ctx context.Context, stream batchedStreamReader) error {
+		if err := stream.SeekToTime(ctx, readFrom); err != nil {
+			return err
+		}
+		r.fireReconnectOnRetryableError(stream, xerrors.WithStackTrace(errSeek))
+
+		return nil
+	})
+}
+
+func (r *readerReconnector) Pause(ctx context.Context, partitionSessionID int64) error {
+	return r.controlWithReconnections(ctx, func(ctx context.Context, stream batchedStreamReader) error {
+		return stream.Pause(ctx, partitionSessionID)
+	})
+}
+
+func (r *readerReconnector) Resume(ctx context.Context, partitionSessionID int64) error {
+	return r.controlWithReconnections(ctx, func(ctx context.Context, stream batchedStreamReader) error {
+		return stream.Resume(ctx, partitionSessionID)
+	})
+}
+
+func (r *readerReconnector) controlWithReconnections(
+	ctx context.Context,
+	control func(ctx context.Context, stream batchedStreamReader) error,
+) error {
+	_, err := r.readWithReconnections(
+		ctx,
+		func(
+			ctx context.Context,
+			stream batchedStreamReader,
+		) (
+			*topicreadercommon.PublicBatch,
+			error,
+		) {
+			return nil, control(ctx, stream)
+		},
+	)
+
+	return err
+}
+
 func (r *readerReconnector) readWithReconnections(
 	ctx context.Context,
 	read func(
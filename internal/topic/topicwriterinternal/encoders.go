@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topiccodecs"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -30,6 +31,7 @@ func NewEncoderMap() *EncoderMap {
 			rawtopiccommon.CodecGzip: func(writer io.Writer) (io.WriteCloser, error) {
 				return gzip.NewWriter(writer), nil
 			},
+			rawtopiccommon.CodecZstd: topiccodecs.NewZstdEncoder(topiccodecs.ZstdDefaultLevel),
 		},
 	}
 }
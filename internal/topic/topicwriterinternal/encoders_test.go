@@ -206,3 +206,26 @@ func TestCompressMessages(t *testing.T) {
 		require.Error(t, cacheMessages(messages, rawtopiccommon.CodecGzip, parallelCount))
 	})
 }
+
+func TestEncoderMap_Zstd(t *testing.T) {
+	m := NewEncoderMap()
+	require.True(t, m.IsSupported(rawtopiccommon.CodecZstd))
+
+	allowed := calculateAllowedCodecs(rawtopiccommon.CodecUNSPECIFIED, m, rawtopiccommon.SupportedCodecs{
+		rawtopiccommon.CodecRaw, rawtopiccommon.CodecZstd,
+	})
+	require.Equal(t, rawtopiccommon.SupportedCodecs{rawtopiccommon.CodecRaw, rawtopiccommon.CodecZstd}, allowed)
+
+	// zstd must not be selected for topics without explicit codecs list
+	allowed = calculateAllowedCodecs(rawtopiccommon.CodecUNSPECIFIED, m, nil)
+	require.NotContains(t, allowed, rawtopiccommon.CodecZstd)
+
+	data := []byte(strings.Repeat("zstd", 100))
+	message := newMessageDataWithContent(PublicMessage{Data: bytes.NewReader(data)}, m)
+	encoded, err := message.GetEncodedBytes(rawtopiccommon.CodecZstd)
+	require.NoError(t, err)
+	require.Less(t, len(encoded), len(data))
+
+	cfg := NewWriterReconnectorConfig(WithZstdLevel(19))
+	require.Contains(t, cfg.AdditionalEncoders, rawtopiccommon.CodecZstd)
+}
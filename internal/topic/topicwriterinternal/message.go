@@ -60,6 +60,10 @@ func NewPartitioningWithPartitionID(id int64) PublicFuturePartitioning {
 type messageWithDataContent struct {
 	PublicMessage
 
+	// routingKey is key of the message in multi writer, it is used for route unacked messages
+	// of inactive partition to other partitions
+	routingKey string
+
 	dataWasRead         bool
 	hasRawContent       bool
 	hasEncodedContent   bool
@@ -0,0 +1,575 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/background"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+var (
+	errMultiWriterClosed    = xerrors.Wrap(errors.New("ydb: multi writer is closed"))
+	errNoActivePartitions   = xerrors.Wrap(errors.New("ydb: topic has no active partitions"))
+	errNoDescribePartitions = xerrors.Wrap(errors.New("ydb: multi writer has no describe partitions function"))
+)
+
+type (
+	// PublicKeyHasher calculates hash of the message key. Messages with equal hashes written to the same partition
+	PublicKeyHasher func(key string) uint64
+
+	// DescribePartitionsFunc returns ids of active partitions of the topic
+	DescribePartitionsFunc func(ctx context.Context) ([]int64, error)
+
+	PublicMultiWriterOption func(cfg *MultiWriterConfig)
+
+	MultiWriterConfig struct {
+		WriterOptions           []PublicWriterOption
+		KeyHasher               PublicKeyHasher
+		PartitionsRefreshPeriod time.Duration
+
+		describePartitions DescribePartitionsFunc
+		newWriter          func(cfg WriterReconnectorConfig, sem *semaphore.Weighted) (partitionWriter, error)
+	}
+
+	partitionWriter interface {
+		Flush(ctx context.Context) error
+		Close(ctx context.Context) error
+
+		write(ctx context.Context, key string, messages []PublicMessage) error
+		writeDrained(ctx context.Context, messages []messageWithDataContent) error
+		drain(ctx context.Context) ([]messageWithDataContent, error)
+	}
+)
+
+func NewMultiWriterConfig(opts ...PublicMultiWriterOption) MultiWriterConfig {
+	cfg := MultiWriterConfig{
+		KeyHasher:               fnvKeyHasher,
+		PartitionsRefreshPeriod: time.Minute,
+		newWriter:               newPartitionWriter,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	return cfg
+}
+
+func WithMultiWriterWriterOptions(opts ...PublicWriterOption) PublicMultiWriterOption {
+	return func(cfg *MultiWriterConfig) {
+		cfg.WriterOptions = append(cfg.WriterOptions, opts...)
+	}
+}
+
+func WithMultiWriterKeyHasher(hasher PublicKeyHasher) PublicMultiWriterOption {
+	return func(cfg *MultiWriterConfig) {
+		cfg.KeyHasher = hasher
+	}
+}
+
+func WithMultiWriterPartitionsRefreshPeriod(period time.Duration) PublicMultiWriterOption {
+	return func(cfg *MultiWriterConfig) {
+		cfg.PartitionsRefreshPeriod = period
+	}
+}
+
+func WithMultiWriterDescribePartitions(describe DescribePartitionsFunc) PublicMultiWriterOption {
+	return func(cfg *MultiWriterConfig) {
+		cfg.describePartitions = describe
+	}
+}
+
+func fnvKeyHasher(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+
+	return h.Sum64()
+}
+
+func newPartitionWriter(cfg WriterReconnectorConfig, sem *semaphore.Weighted) (partitionWriter, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	w := newWriterReconnectorStopped(cfg)
+	w.semaphore = sem
+	if err := w.restorePersistentQueue(); err != nil {
+		return nil, err
+	}
+	w.start()
+
+	return w, nil
+}
+
+// MultiWriter writes messages to all partitions of topic, one writer per partition.
+// Messages routed to partitions by hash of their keys, so messages with the same key
+// keep order within partition.
+type MultiWriter struct {
+	cfg        MultiWriterConfig
+	writerCfg  WriterReconnectorConfig
+	semaphore  *semaphore.Weighted
+	background background.Worker
+	refreshNow empty.Chan
+
+	m          xsync.RWMutex
+	partitions []int64 // sorted ids of active partitions, routing table
+	writers    map[int64]partitionWriter
+	closed     bool
+
+	// writers of partitions from persistent queue directory, which are not in routing table,
+	// they send unacked messages of previous process and not used for new messages
+	replayWriters map[int64]partitionWriter
+}
+
+func NewMultiWriter(cfg MultiWriterConfig) (*MultiWriter, error) { //nolint:gocritic
+	if cfg.describePartitions == nil {
+		return nil, xerrors.WithStackTrace(errNoDescribePartitions)
+	}
+
+	writerCfg := NewWriterReconnectorConfig(cfg.WriterOptions...)
+	w := &MultiWriter{
+		cfg:           cfg,
+		writerCfg:     writerCfg,
+		semaphore:     semaphore.NewWeighted(int64(writerCfg.MaxQueueLen)),
+		refreshNow:    make(empty.Chan, 1),
+		writers:       make(map[int64]partitionWriter),
+		replayWriters: make(map[int64]partitionWriter),
+	}
+
+	if err := w.startReplayWriters(); err != nil {
+		_ = w.Close(context.Background())
+
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// startReplayWriters starts writers for all partitions with unacked messages in persistent queue directory,
+// then messages are sent without wait of first Write and even if the partition is not active now
+func (w *MultiWriter) startReplayWriters() error {
+	if w.writerCfg.persistentQueueDir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(w.writerCfg.persistentQueueDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return xerrors.WithStackTrace(err)
+	}
+
+	for _, entry := range entries {
+		id, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(w.writerCfg.persistentQueueDir, entry.Name(), persistentQueueFileName))
+		if err != nil || info.Size() == 0 {
+			// no messages for replay
+			continue
+		}
+
+		writer, err := w.newPartitionWriter(id)
+		if err != nil {
+			return err
+		}
+		w.replayWriters[id] = writer
+	}
+
+	return nil
+}
+
+// Write messages with the key to partition, chosen by hash of the key
+func (w *MultiWriter) Write(ctx context.Context, key string, messages []PublicMessage) error {
+	if err := w.init(ctx); err != nil {
+		return err
+	}
+
+	w.m.RLock()
+	defer w.m.RUnlock()
+
+	if w.closed {
+		return xerrors.WithStackTrace(errMultiWriterClosed)
+	}
+	writer := w.writers[rendezvousPartition(w.cfg.KeyHasher(key), w.partitions)]
+
+	return writer.write(ctx, key, messages)
+}
+
+// Flush waits till all in-flight messages of all partitions are acknowledged
+func (w *MultiWriter) Flush(ctx context.Context) error {
+	w.m.RLock()
+	defer w.m.RUnlock()
+
+	if err := flushWriters(ctx, w.replayWriters); err != nil {
+		return err
+	}
+
+	return flushWriters(ctx, w.writers)
+}
+
+func flushWriters(ctx context.Context, writers map[int64]partitionWriter) error {
+	errs := make([]error, 0, len(writers))
+	for _, writer := range writers {
+		if err := writer.Flush(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Close flushes messages of all partitions and closes writers
+func (w *MultiWriter) Close(ctx context.Context) error {
+	var writers []partitionWriter
+	w.m.WithLock(func() {
+		if w.closed {
+			return
+		}
+		w.closed = true
+		for _, writer := range w.writers {
+			writers = append(writers, writer)
+		}
+		for _, writer := range w.replayWriters {
+			writers = append(writers, writer)
+		}
+	})
+
+	errs := make([]error, 0, len(writers)+1)
+	if err := w.background.Close(ctx, xerrors.WithStackTrace(errMultiWriterClosed)); err != nil {
+		errs = append(errs, err)
+	}
+	for _, writer := range writers {
+		if err := writer.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (w *MultiWriter) init(ctx context.Context) error {
+	var initialized bool
+	w.m.WithRLock(func() {
+		initialized = w.partitions != nil || w.closed
+	})
+	if initialized {
+		return nil
+	}
+
+	partitions, err := w.cfg.describePartitions(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var started bool
+	err = xsync.WithLock(&w.m, func() error {
+		if w.partitions != nil || w.closed {
+			return nil
+		}
+		started = true
+
+		return w.setPartitions(ctx, partitions)
+	})
+	if err != nil {
+		return err
+	}
+	if started && w.cfg.PartitionsRefreshPeriod > 0 {
+		w.background.Start(fmt.Sprintf("multi writer %q, refresh partitions", w.writerCfg.topic), w.refreshLoop)
+	}
+
+	return nil
+}
+
+func (w *MultiWriter) refreshLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.writerCfg.clock.After(w.cfg.PartitionsRefreshPeriod):
+		case <-w.refreshNow:
+		}
+
+		// partitions will be refreshed at next period after error
+		_ = w.refresh(ctx)
+	}
+}
+
+// refresh recomputes routing table if set of active partitions changed
+func (w *MultiWriter) refresh(ctx context.Context) error {
+	partitions, err := w.cfg.describePartitions(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xsync.WithLock(&w.m, func() error {
+		if w.closed || equalPartitions(w.partitions, partitions) {
+			return nil
+		}
+
+		// partitions are deactivated after split or merge, they don't accept messages anymore
+		inactive := w.inactiveWriters(partitions)
+
+		// messages of keys, which change partition, must be written before routing change,
+		// else messages with the same key may be reordered by write to different partitions
+		moved := make(map[int64]partitionWriter)
+		for id, writer := range w.movedKeysWriters(partitions) {
+			if _, has := inactive[id]; !has {
+				moved[id] = writer
+			}
+		}
+		if err := flushWriters(ctx, moved); err != nil {
+			return err
+		}
+
+		drained, err := w.drainWriters(ctx, inactive)
+		if err != nil {
+			return err
+		}
+
+		if err = w.setPartitions(ctx, partitions); err != nil {
+			return err
+		}
+
+		return w.writeDrained(ctx, inactive, drained)
+	})
+}
+
+// requestRefresh starts refresh of partitions without wait of refresh period,
+// it called on reconnect of partition writer, because reconnect may be caused by split of the partition
+func (w *MultiWriter) requestRefresh() {
+	select {
+	case w.refreshNow <- empty.Struct{}:
+	default:
+	}
+}
+
+// inactiveWriters returns writers of partitions, which are not active anymore.
+// It must be called with locked mutex.
+func (w *MultiWriter) inactiveWriters(partitions []int64) map[int64]partitionWriter {
+	active := make(map[int64]struct{}, len(partitions))
+	for _, id := range partitions {
+		active[id] = struct{}{}
+	}
+
+	res := make(map[int64]partitionWriter)
+	for id, writer := range w.writers {
+		if _, has := active[id]; !has {
+			res[id] = writer
+		}
+	}
+
+	return res
+}
+
+// drainWriters closes writers of inactive partitions and returns their unacked messages,
+// messages of every writer ordered by write. It must be called with locked mutex.
+func (w *MultiWriter) drainWriters(
+	ctx context.Context,
+	writers map[int64]partitionWriter,
+) ([]messageWithDataContent, error) {
+	ids := make([]int64, 0, len(writers))
+	for id := range writers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	var res []messageWithDataContent
+	for _, id := range ids {
+		messages, err := writers[id].drain(ctx)
+		delete(w.writers, id)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		res = append(res, messages...)
+	}
+
+	return res, nil
+}
+
+// writeDrained writes unacked messages of inactive partitions to active partitions by their keys
+// before any new message, then messages with the same key keep order.
+// Messages in flight to inactive partitions may be duplicated.
+// It must be called with locked mutex.
+func (w *MultiWriter) writeDrained(
+	ctx context.Context,
+	inactive map[int64]partitionWriter,
+	messages []messageWithDataContent,
+) error {
+	byPartition := make(map[int64][]messageWithDataContent)
+	for i := range messages {
+		id := rendezvousPartition(w.cfg.KeyHasher(messages[i].routingKey), w.partitions)
+		byPartition[id] = append(byPartition[id], messages[i])
+	}
+	for id, partitionMessages := range byPartition {
+		if err := w.writers[id].writeDrained(ctx, partitionMessages); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	// messages are in persistent queues of active partitions now
+	if w.writerCfg.persistentQueueDir != "" {
+		for id := range inactive {
+			dir := filepath.Join(w.writerCfg.persistentQueueDir, strconv.FormatInt(id, 10))
+			if err := os.RemoveAll(dir); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// setPartitions must be called with locked mutex
+func (w *MultiWriter) setPartitions(ctx context.Context, partitions []int64) error {
+	if len(partitions) == 0 {
+		return xerrors.WithStackTrace(errNoActivePartitions)
+	}
+	partitions = append([]int64(nil), partitions...)
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i] < partitions[j]
+	})
+
+	active := make(map[int64]struct{}, len(partitions))
+	for _, id := range partitions {
+		active[id] = struct{}{}
+		if _, has := w.writers[id]; has {
+			continue
+		}
+
+		// new messages of the partition must be written after replayed, by the same writer
+		if writer, has := w.replayWriters[id]; has {
+			delete(w.replayWriters, id)
+			w.writers[id] = writer
+
+			continue
+		}
+
+		writer, err := w.newPartitionWriter(id)
+		if err != nil {
+			return err
+		}
+		w.writers[id] = writer
+	}
+	for id, writer := range w.writers {
+		if _, has := active[id]; !has {
+			delete(w.writers, id)
+			// writer is already flushed, close is fast
+			if err := writer.Close(ctx); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	w.partitions = partitions
+
+	return nil
+}
+
+func (w *MultiWriter) newPartitionWriter(id int64) (partitionWriter, error) {
+	cfg := w.writerCfg
+	cfg.producerID = fmt.Sprintf("%s-%d", w.writerCfg.producerID, id)
+	cfg.defaultPartitioning = NewPartitioningWithPartitionID(id).ToRaw()
+	cfg.onReconnect = w.requestRefresh
+	if cfg.persistentQueueDir != "" {
+		cfg.persistentQueueDir = filepath.Join(cfg.persistentQueueDir, strconv.FormatInt(id, 10))
+	}
+	writer, err := w.cfg.newWriter(cfg, w.semaphore)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return writer, nil
+}
+
+// movedKeysWriters returns writers of partitions, which may lose keys after routing change.
+// Rendezvous hashing moves keys from all partitions to added partitions only,
+// and keys of removed partitions only to other partitions.
+func (w *MultiWriter) movedKeysWriters(partitions []int64) map[int64]partitionWriter {
+	active := make(map[int64]struct{}, len(partitions))
+	for _, id := range partitions {
+		if _, has := w.writers[id]; !has {
+			// partition added, keys of all existing partitions may move to it
+			return w.writers
+		}
+		active[id] = struct{}{}
+	}
+
+	removed := make(map[int64]partitionWriter)
+	for id, writer := range w.writers {
+		if _, has := active[id]; !has {
+			removed[id] = writer
+		}
+	}
+
+	return removed
+}
+
+// rendezvousPartition chooses partition for the key hash by rendezvous (highest random weight) hashing:
+// weight of every partition is mix of the key hash and partition id, partition with max weight is chosen.
+// When partition added - only keys with max weight on the new partition (about 1/N of all keys) move to it,
+// when partition removed - only keys of the partition move to other partitions, other keys keep their partitions.
+// partitions must be sorted and not empty.
+func rendezvousPartition(keyHash uint64, partitions []int64) int64 {
+	res := partitions[0]
+	maxWeight := mix64(keyHash ^ mix64(uint64(res)))
+	for _, id := range partitions[1:] {
+		if weight := mix64(keyHash ^ mix64(uint64(id))); weight > maxWeight {
+			res, maxWeight = id, weight
+		}
+	}
+
+	return res
+}
+
+// mix64 is the finalizer of splitmix64
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
+
+func equalPartitions(sorted, partitions []int64) bool {
+	if len(sorted) != len(partitions) {
+		return false
+	}
+	partitions = append([]int64(nil), partitions...)
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i] < partitions[j]
+	})
+	for i := range sorted {
+		if sorted[i] != partitions[i] {
+			return false
+		}
+	}
+
+	return true
+}
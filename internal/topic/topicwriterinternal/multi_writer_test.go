@@ -0,0 +1,334 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testPartitionWriter struct {
+	cfg WriterReconnectorConfig
+	sem *semaphore.Weighted
+
+	m        sync.Mutex
+	messages []PublicMessage
+	keys     []string
+	flushes  int
+	closed   bool
+	drained  bool
+}
+
+func (w *testPartitionWriter) write(ctx context.Context, key string, messages []PublicMessage) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.messages = append(w.messages, messages...)
+	for range messages {
+		w.keys = append(w.keys, key)
+	}
+
+	return nil
+}
+
+func (w *testPartitionWriter) writeDrained(ctx context.Context, messages []messageWithDataContent) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	for i := range messages {
+		w.messages = append(w.messages, messages[i].PublicMessage)
+		w.keys = append(w.keys, messages[i].routingKey)
+	}
+
+	return nil
+}
+
+func (w *testPartitionWriter) drain(ctx context.Context) ([]messageWithDataContent, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.drained = true
+	w.closed = true
+
+	res := make([]messageWithDataContent, len(w.messages))
+	for i := range w.messages {
+		res[i] = newMessageDataWithContent(w.messages[i], nil)
+		res[i].routingKey = w.keys[i]
+	}
+
+	return res, nil
+}
+
+func (w *testPartitionWriter) Flush(ctx context.Context) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.flushes++
+
+	return nil
+}
+
+func (w *testPartitionWriter) Close(ctx context.Context) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.closed = true
+
+	return nil
+}
+
+type testMultiWriterEnv struct {
+	writer     *MultiWriter
+	partitions []int64
+	writers    map[int64]*testPartitionWriter
+}
+
+func newTestMultiWriter(t *testing.T, partitions []int64, opts ...PublicMultiWriterOption) *testMultiWriterEnv {
+	env := &testMultiWriterEnv{
+		partitions: partitions,
+		writers:    make(map[int64]*testPartitionWriter),
+	}
+	cfg := NewMultiWriterConfig(append([]PublicMultiWriterOption{
+		WithMultiWriterPartitionsRefreshPeriod(0),
+		WithMultiWriterWriterOptions(WithProducerID("producer"), WithMaxQueueLen(10)),
+		WithMultiWriterDescribePartitions(func(ctx context.Context) ([]int64, error) {
+			return env.partitions, nil
+		}),
+	}, opts...)...)
+	cfg.newWriter = func(cfg WriterReconnectorConfig, sem *semaphore.Weighted) (partitionWriter, error) {
+		w := &testPartitionWriter{cfg: cfg, sem: sem}
+		env.writers[cfg.defaultPartitioning.PartitionID] = w
+
+		return w, nil
+	}
+
+	var err error
+	env.writer, err = NewMultiWriter(cfg)
+	require.NoError(t, err)
+
+	return env
+}
+
+func TestMultiWriter(t *testing.T) {
+	keyHasher := func(key string) uint64 {
+		return uint64(len(key))
+	}
+
+	t.Run("Routing", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		env := newTestMultiWriter(t, []int64{2, 0, 1}, WithMultiWriterKeyHasher(keyHasher))
+
+		require.NoError(t, env.writer.Write(ctx, "a", []PublicMessage{{SeqNo: 1}}))
+		require.NoError(t, env.writer.Write(ctx, "bb", []PublicMessage{{SeqNo: 2}, {SeqNo: 3}}))
+		require.NoError(t, env.writer.Write(ctx, "cccc", []PublicMessage{{SeqNo: 4}}))
+		require.NoError(t, env.writer.Write(ctx, "", []PublicMessage{{SeqNo: 5}}))
+
+		require.Len(t, env.writers, 3)
+		require.Empty(t, env.writers[0].messages)
+		require.Equal(t, []PublicMessage{{SeqNo: 1}, {SeqNo: 4}, {SeqNo: 5}}, env.writers[1].messages)
+		require.Equal(t, []PublicMessage{{SeqNo: 2}, {SeqNo: 3}}, env.writers[2].messages)
+
+		for id, w := range env.writers {
+			require.Equal(t, rawtopicwriter.PartitioningPartitionID, w.cfg.defaultPartitioning.Type)
+			require.Equal(t, id, w.cfg.defaultPartitioning.PartitionID)
+			require.Equal(t, env.writers[0].sem, w.sem, "queue limit must be shared")
+		}
+		require.Equal(t, "producer-1", env.writers[1].cfg.producerID)
+		require.Equal(t, 10, env.writers[1].cfg.MaxQueueLen)
+
+		require.NoError(t, env.writer.Flush(ctx))
+		for _, w := range env.writers {
+			require.Equal(t, 1, w.flushes)
+		}
+
+		require.NoError(t, env.writer.Close(ctx))
+		for _, w := range env.writers {
+			require.True(t, w.closed)
+		}
+		require.ErrorIs(t, env.writer.Write(ctx, "a", []PublicMessage{{}}), errMultiWriterClosed)
+	})
+	t.Run("PartitionsChanged", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		env := newTestMultiWriter(t, []int64{0, 1}, WithMultiWriterKeyHasher(keyHasher))
+
+		require.NoError(t, env.writer.Write(ctx, "bb", []PublicMessage{{SeqNo: 1}}))
+		require.NoError(t, env.writer.Write(ctx, "ccc", []PublicMessage{{SeqNo: 2}}))
+		require.Equal(t, []PublicMessage{{SeqNo: 1}, {SeqNo: 2}}, env.writers[1].messages)
+
+		require.NoError(t, env.writer.refresh(ctx))
+		require.Equal(t, 0, env.writers[1].flushes, "no flush without changes")
+
+		// keys of all partitions may move to added partition
+		env.partitions = []int64{0, 1, 2}
+		require.NoError(t, env.writer.refresh(ctx))
+		require.Equal(t, 1, env.writers[0].flushes)
+		require.Equal(t, 1, env.writers[1].flushes)
+		require.Equal(t, []int64{0, 1, 2}, env.writer.partitions)
+
+		require.NoError(t, env.writer.Write(ctx, "bb", []PublicMessage{{SeqNo: 3}}))
+		require.NoError(t, env.writer.Write(ctx, "ccc", []PublicMessage{{SeqNo: 4}}))
+		require.Equal(t, []PublicMessage{{SeqNo: 3}}, env.writers[2].messages, "key moved to new partition")
+		require.Equal(t, []PublicMessage{{SeqNo: 1}, {SeqNo: 2}, {SeqNo: 4}}, env.writers[1].messages)
+
+		// keys of removed partition only move to other partitions,
+		// removed partition is inactive and its writer is drained instead of flush
+		env.partitions = []int64{1, 2}
+		require.NoError(t, env.writer.refresh(ctx))
+		require.Equal(t, 1, env.writers[0].flushes)
+		require.Equal(t, 1, env.writers[1].flushes)
+		require.Equal(t, 0, env.writers[2].flushes)
+		require.True(t, env.writers[0].drained)
+		require.True(t, env.writers[0].closed)
+		require.NotContains(t, env.writer.writers, int64(0))
+	})
+	t.Run("PartitionSplit", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		dir := t.TempDir()
+		env := newTestMultiWriter(t, []int64{0, 1},
+			WithMultiWriterWriterOptions(WithPersistentQueue(dir)),
+		)
+
+		keys := make([]string, 20)
+		for i := range keys {
+			keys[i] = strconv.Itoa(i)
+			require.NoError(t, env.writer.Write(ctx, keys[i], []PublicMessage{{SeqNo: int64(i + 1)}}))
+		}
+		require.NotEmpty(t, env.writers[0].messages)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "0"), 0o755))
+
+		// reconnect of partition writer starts refresh of partitions
+		require.NotNil(t, env.writers[0].cfg.onReconnect)
+		env.writers[0].cfg.onReconnect()
+		env.writers[0].cfg.onReconnect()
+		require.Len(t, env.writer.refreshNow, 1)
+
+		// partition 0 split to 2 and 3
+		env.partitions = []int64{1, 2, 3}
+		require.NoError(t, env.writer.refresh(ctx))
+		require.True(t, env.writers[0].drained)
+		require.NotContains(t, env.writer.writers, int64(0))
+		require.Equal(t, 1, env.writers[1].flushes)
+		require.NoDirExists(t, filepath.Join(dir, "0"))
+
+		// messages of the active partition 1 are flushed before routing change and stay there
+		flushed := len(env.writers[1].keys)
+
+		// unacked messages of the parent written to children before new messages
+		for i, key := range keys {
+			require.NoError(t, env.writer.Write(ctx, key, []PublicMessage{{SeqNo: int64(len(keys) + i + 1)}}))
+		}
+		for _, id := range []int64{1, 2, 3} {
+			w := env.writers[id]
+			lastSeqNo := make(map[string]int64)
+			for i, key := range w.keys {
+				if id == 1 && i < flushed {
+					continue
+				}
+				require.Equal(t, id, rendezvousPartition(fnvKeyHasher(key), env.writer.partitions))
+				require.Greater(t, w.messages[i].SeqNo, lastSeqNo[key], "messages of key %q reordered", key)
+				lastSeqNo[key] = w.messages[i].SeqNo
+			}
+		}
+		require.Equal(t, len(keys)*2, len(env.writers[1].keys)+len(env.writers[2].keys)+len(env.writers[3].keys))
+	})
+	t.Run("ReplayPersistentQueue", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		dir := t.TempDir()
+		for _, name := range []string{"1", "5", "7", "tmp"} {
+			require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0o755))
+		}
+		for _, name := range []string{"1", "5", "tmp"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name, persistentQueueFileName), []byte{1}, 0o600))
+		}
+
+		env := newTestMultiWriter(t, []int64{0, 1},
+			WithMultiWriterKeyHasher(keyHasher),
+			WithMultiWriterWriterOptions(WithPersistentQueue(dir)),
+		)
+
+		// writers with unacked messages started before first write, partition 7 has no messages
+		require.Len(t, env.writers, 2)
+		require.Equal(t, filepath.Join(dir, "5"), env.writers[5].cfg.persistentQueueDir)
+		require.Equal(t, "producer-5", env.writers[5].cfg.producerID)
+		require.NoError(t, env.writer.Flush(ctx))
+		require.Equal(t, 1, env.writers[1].flushes)
+		require.Equal(t, 1, env.writers[5].flushes)
+
+		// writer of active partition continues write after replay, inactive partition writer keeps replay
+		replayWriter := env.writers[1]
+		require.NoError(t, env.writer.Write(ctx, "a", []PublicMessage{{SeqNo: 1}}))
+		require.Same(t, replayWriter, env.writers[1])
+		require.Equal(t, []PublicMessage{{SeqNo: 1}}, replayWriter.messages)
+		require.False(t, env.writers[5].closed)
+
+		require.NoError(t, env.writer.Close(ctx))
+		require.True(t, env.writers[1].closed)
+		require.True(t, env.writers[5].closed)
+	})
+	t.Run("DescribeError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		testErr := errors.New("test")
+		env := newTestMultiWriter(t, nil, WithMultiWriterDescribePartitions(func(ctx context.Context) ([]int64, error) {
+			return nil, testErr
+		}))
+		require.ErrorIs(t, env.writer.Write(ctx, "a", []PublicMessage{{}}), testErr)
+
+		env = newTestMultiWriter(t, []int64{})
+		require.ErrorIs(t, env.writer.Write(ctx, "a", []PublicMessage{{}}), errNoActivePartitions)
+	})
+}
+
+func TestRendezvousPartition(t *testing.T) {
+	const keysCount = 10000
+
+	route := func(partitions []int64) []int64 {
+		res := make([]int64, keysCount)
+		for i := range res {
+			res[i] = rendezvousPartition(fnvKeyHasher(strconv.Itoa(i)), partitions)
+		}
+
+		return res
+	}
+
+	partitions := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	before := route(partitions)
+
+	counts := make(map[int64]int)
+	for _, id := range before {
+		counts[id]++
+	}
+	for _, id := range partitions {
+		require.InDelta(t, keysCount/len(partitions), counts[id], float64(keysCount/len(partitions)/5))
+	}
+
+	t.Run("Added", func(t *testing.T) {
+		after := route(append(append([]int64(nil), partitions...), 10))
+		moved := 0
+		for i := range before {
+			if before[i] != after[i] {
+				require.Equal(t, int64(10), after[i], "keys move to added partition only")
+				moved++
+			}
+		}
+		require.InDelta(t, keysCount/11, moved, float64(keysCount/11/5))
+	})
+	t.Run("Removed", func(t *testing.T) {
+		after := route([]int64{0, 1, 2, 4, 5, 6, 7, 8, 9})
+		for i := range before {
+			if before[i] != 3 {
+				require.Equal(t, before[i], after[i], "keys of other partitions keep their partitions")
+			} else {
+				require.NotEqual(t, int64(3), after[i])
+			}
+		}
+	})
+}
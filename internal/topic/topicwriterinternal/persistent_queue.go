@@ -0,0 +1,498 @@
+package topicwriterinternal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+const (
+	persistentQueueFileName = "messages.log"
+
+	persistentRecordMessage = byte(1)
+	persistentRecordAck     = byte(2)
+
+	// type (1 byte), payload length (4 bytes), crc32 of payload (4 bytes)
+	persistentRecordHeaderSize = 9
+
+	// log rewrites with unacked messages only when it is greater than the size
+	// and acked messages take more than half of it
+	persistentQueueCompactSize = 64 * 1024 * 1024
+)
+
+var (
+	errPersistentQueueCorrupted       = xerrors.Wrap(errors.New("ydb: persistent queue record is corrupted"))
+	errPersistentQueueNoContent       = xerrors.Wrap(errors.New("ydb: message has no content for persistent queue"))
+	errPersistentQueueProducerID      = xerrors.Wrap(errors.New("ydb: persistent queue needs stable producer id, set it with WithWriterProducerID")) //nolint:lll
+	errPersistentQueueTooManyMessages = xerrors.Wrap(errors.New("ydb: persistent queue has more unacked messages than max queue len"))               //nolint:lll
+	errPersistentQueueTransaction     = xerrors.Wrap(errors.New("ydb: persistent queue doesn't support write messages within transaction"))          //nolint:lll
+)
+
+// persistentQueue is a write-ahead log of messages, which were written to writer but not acked by server yet.
+// Messages appended to log (with fsync) before add to messageQueue, acks appended when received from server.
+// Unacked messages replayed to messageQueue on writer start, duplicates are skipped by server
+// by producer id and seqno. Partially written last record is truncated on open,
+// other corrupted records fail open of the queue.
+type persistentQueue struct {
+	m xsync.Mutex
+
+	dir      string
+	file     *os.File
+	size     int64
+	liveSize int64
+	entries  map[int64]persistentEntry // position of message record by seqno
+	buf      []byte
+}
+
+type persistentEntry struct {
+	offset int64
+	size   int64
+}
+
+// openPersistentQueue opens log in dir and returns unacked messages ordered by seqno
+func openPersistentQueue(dir string, encoders *EncoderMap) (*persistentQueue, []messageWithDataContent, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	file, err := os.OpenFile(filepath.Join(dir, persistentQueueFileName), os.O_RDWR|os.O_CREATE, 0o644) //nolint:gomnd
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	q := &persistentQueue{
+		dir:     dir,
+		file:    file,
+		entries: make(map[int64]persistentEntry),
+	}
+
+	messages, err := q.load(encoders)
+	if err != nil {
+		_ = file.Close()
+
+		return nil, nil, err
+	}
+
+	return q, messages, nil
+}
+
+func (q *persistentQueue) load(encoders *EncoderMap) ([]messageWithDataContent, error) {
+	info, err := q.file.Stat()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	var (
+		reader   = bufio.NewReader(q.file)
+		messages = make(map[int64]messageWithDataContent)
+		header   [persistentRecordHeaderSize]byte
+		offset   int64
+	)
+	for {
+		recordType, payload, err := readPersistentRecord(reader, header[:])
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, errPersistentQueueCorrupted) {
+			// last record of log may be partially written on crash, it was not acked to writer caller.
+			// Corrupted records in the middle of log mean lost of messages, which were acked to caller.
+			var isTail bool
+			if isTail, err = q.isTornTail(offset, header[:], info.Size()); err == nil && !isTail {
+				err = xerrors.WithStackTrace(fmt.Errorf("ydb: persistent queue %q, offset %v: %w",
+					q.file.Name(), offset, errPersistentQueueCorrupted,
+				))
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			break
+		}
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		size := int64(persistentRecordHeaderSize + len(payload))
+
+		switch recordType {
+		case persistentRecordMessage:
+			msg, err := decodePersistentMessage(payload, encoders)
+			if err != nil {
+				return nil, err
+			}
+			messages[msg.SeqNo] = msg
+			q.entries[msg.SeqNo] = persistentEntry{offset: offset, size: size}
+			q.liveSize += size
+		case persistentRecordAck:
+			for len(payload) >= 8 { //nolint:gomnd
+				seqNo := int64(binary.LittleEndian.Uint64(payload))
+				payload = payload[8:]
+				if entry, ok := q.entries[seqNo]; ok {
+					q.liveSize -= entry.size
+					delete(q.entries, seqNo)
+					delete(messages, seqNo)
+				}
+			}
+		}
+		offset += size
+	}
+
+	if err := q.file.Truncate(offset); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if _, err := q.file.Seek(offset, io.SeekStart); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	q.size = offset
+
+	res := make([]messageWithDataContent, 0, len(messages))
+	for seqNo := range messages {
+		res = append(res, messages[seqNo])
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].SeqNo < res[j].SeqNo
+	})
+
+	return res, nil
+}
+
+// isTornTail checks that the corrupted record at offset is the last record of log:
+// nothing or only zeroes (file size extended without data on crash) are written after the record
+func (q *persistentQueue) isTornTail(offset int64, header []byte, fileSize int64) (bool, error) {
+	if offset+persistentRecordHeaderSize > fileSize {
+		// header partially written
+		return true, nil
+	}
+
+	restOffset := offset
+	if recordType := header[0]; recordType == persistentRecordMessage || recordType == persistentRecordAck {
+		restOffset += persistentRecordHeaderSize + int64(binary.LittleEndian.Uint32(header[1:]))
+	}
+	if restOffset >= fileSize {
+		return true, nil
+	}
+
+	rest, err := io.ReadAll(io.NewSectionReader(q.file, restOffset, fileSize-restOffset))
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+
+	return bytes.Count(rest, []byte{0}) == len(rest), nil
+}
+
+// Append writes messages to log and waits they are saved to disk.
+// Messages must have cached content and filled seqno.
+func (q *persistentQueue) Append(messages []messageWithDataContent) error {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	q.buf = q.buf[:0]
+	offsets := make([]int64, len(messages))
+	for i := range messages {
+		offsets[i] = int64(len(q.buf))
+		var err error
+		q.buf, err = appendPersistentMessage(q.buf, &messages[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := q.file.Write(q.buf); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err := q.file.Sync(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	for i := range messages {
+		end := int64(len(q.buf))
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		q.entries[messages[i].SeqNo] = persistentEntry{offset: q.size + offsets[i], size: end - offsets[i]}
+		q.liveSize += end - offsets[i]
+	}
+	q.size += int64(len(q.buf))
+
+	return nil
+}
+
+// Ack removes messages from log. Acks are not synced to disk: lost acks lead
+// to replay of messages, which will be skipped by server as duplicates.
+func (q *persistentQueue) Ack(acks []rawtopicwriter.WriteAck) error {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	payload := make([]byte, 0, 8*len(acks)) //nolint:gomnd
+	for i := range acks {
+		entry, ok := q.entries[acks[i].SeqNo]
+		if !ok {
+			continue
+		}
+		q.liveSize -= entry.size
+		delete(q.entries, acks[i].SeqNo)
+		payload = binary.LittleEndian.AppendUint64(payload, uint64(acks[i].SeqNo))
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+
+	if len(q.entries) == 0 {
+		return q.reset()
+	}
+
+	q.buf = appendPersistentRecord(q.buf[:0], persistentRecordAck, payload)
+	if _, err := q.file.Write(q.buf); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	q.size += int64(len(q.buf))
+
+	if q.size > persistentQueueCompactSize && q.liveSize*2 < q.size {
+		return q.compact()
+	}
+
+	return nil
+}
+
+func (q *persistentQueue) Close() error {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if err := q.file.Close(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// reset truncates log without unacked messages, must be called with locked mutex
+func (q *persistentQueue) reset() error {
+	if err := q.file.Truncate(0); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if _, err := q.file.Seek(0, io.SeekStart); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	q.size = 0
+	q.liveSize = 0
+
+	return nil
+}
+
+// compact rewrites log with unacked messages only, must be called with locked mutex
+func (q *persistentQueue) compact() (err error) {
+	seqNos := make([]int64, 0, len(q.entries))
+	for seqNo := range q.entries {
+		seqNos = append(seqNos, seqNo)
+	}
+	sort.Slice(seqNos, func(i, j int) bool {
+		return q.entries[seqNos[i]].offset < q.entries[seqNos[j]].offset
+	})
+
+	path := filepath.Join(q.dir, persistentQueueFileName)
+	tmp, err := os.OpenFile(path+".tmp", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644) //nolint:gomnd
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(path + ".tmp")
+		}
+	}()
+
+	entries := make(map[int64]persistentEntry, len(seqNos))
+	var offset int64
+	for _, seqNo := range seqNos {
+		entry := q.entries[seqNo]
+		if _, err = io.Copy(tmp, io.NewSectionReader(q.file, entry.offset, entry.size)); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		entries[seqNo] = persistentEntry{offset: offset, size: entry.size}
+		offset += entry.size
+	}
+	if err = tmp.Sync(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	_ = q.file.Close()
+	q.file = tmp
+	q.entries = entries
+	q.size = offset
+	q.liveSize = offset
+
+	return nil
+}
+
+func readPersistentRecord(r io.Reader, header []byte) (recordType byte, payload []byte, err error) {
+	if _, err = io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, nil, xerrors.WithStackTrace(errPersistentQueueCorrupted)
+		}
+
+		return 0, nil, err
+	}
+
+	recordType = header[0]
+	if recordType != persistentRecordMessage && recordType != persistentRecordAck {
+		return 0, nil, xerrors.WithStackTrace(errPersistentQueueCorrupted)
+	}
+	payload = make([]byte, binary.LittleEndian.Uint32(header[1:]))
+	if _, err = io.ReadFull(r, payload); err != nil {
+		return 0, nil, xerrors.WithStackTrace(errPersistentQueueCorrupted)
+	}
+	if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(header[5:]) {
+		return 0, nil, xerrors.WithStackTrace(errPersistentQueueCorrupted)
+	}
+
+	return recordType, payload, nil
+}
+
+func appendPersistentRecord(b []byte, recordType byte, payload []byte) []byte {
+	b = append(b, recordType)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(payload)))
+	b = binary.LittleEndian.AppendUint32(b, crc32.ChecksumIEEE(payload))
+
+	return append(b, payload...)
+}
+
+// appendPersistentMessage appends message record:
+// seqno, created at (unix nano, 0 for zero time), codec, uncompressed size, metadata, data
+func appendPersistentMessage(b []byte, msg *messageWithDataContent) ([]byte, error) {
+	var (
+		codec rawtopiccommon.Codec
+		data  []byte
+	)
+	switch {
+	case msg.hasRawContent:
+		codec, data = rawtopiccommon.CodecRaw, msg.rawBuf.Bytes()
+	case msg.hasEncodedContent:
+		codec, data = msg.bufCodec, msg.bufEncoded.Bytes()
+	default:
+		return nil, xerrors.WithStackTrace(errPersistentQueueNoContent)
+	}
+
+	var createdAt int64
+	if !msg.CreatedAt.IsZero() {
+		createdAt = msg.CreatedAt.UnixNano()
+	}
+
+	payload := binary.LittleEndian.AppendUint64(nil, uint64(msg.SeqNo))
+	payload = binary.LittleEndian.AppendUint64(payload, uint64(createdAt))
+	payload = binary.LittleEndian.AppendUint32(payload, uint32(codec))
+	payload = binary.LittleEndian.AppendUint64(payload, uint64(msg.BufUncompressedSize))
+	payload = binary.LittleEndian.AppendUint32(payload, uint32(len(msg.Metadata)))
+	for key, val := range msg.Metadata {
+		payload = appendPersistentBytes(payload, []byte(key))
+		payload = appendPersistentBytes(payload, val)
+	}
+	payload = appendPersistentBytes(payload, data)
+
+	return appendPersistentRecord(b, persistentRecordMessage, payload), nil
+}
+
+func appendPersistentBytes(b, data []byte) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data)))
+
+	return append(b, data...)
+}
+
+func decodePersistentMessage(payload []byte, encoders *EncoderMap) (msg messageWithDataContent, err error) {
+	r := persistentReader{b: payload}
+
+	seqNo := int64(r.uint64())
+	createdAt := int64(r.uint64())
+	codec := rawtopiccommon.Codec(r.uint32())
+	uncompressedSize := int(r.uint64())
+
+	var metadata map[string][]byte
+	if count := r.uint32(); count > 0 {
+		metadata = make(map[string][]byte)
+		for i := uint32(0); i < count && r.err == nil; i++ {
+			key := r.bytes()
+			metadata[string(key)] = r.bytes()
+		}
+	}
+	data := r.bytes()
+	if r.err != nil {
+		return msg, xerrors.WithStackTrace(fmt.Errorf("%w: %w", errPersistentQueueCorrupted, r.err))
+	}
+
+	msg = newMessageDataWithContent(PublicMessage{
+		SeqNo:    seqNo,
+		Metadata: metadata,
+	}, encoders)
+	if createdAt != 0 {
+		msg.CreatedAt = time.Unix(0, createdAt)
+	}
+	msg.metadataCached = true
+	msg.BufUncompressedSize = uncompressedSize
+	if codec == rawtopiccommon.CodecRaw {
+		msg.hasRawContent = true
+		msg.rawBuf.Write(data)
+	} else {
+		msg.dataWasRead = true
+		msg.hasEncodedContent = true
+		msg.bufCodec = codec
+		msg.bufEncoded.Write(data)
+	}
+
+	return msg, nil
+}
+
+type persistentReader struct {
+	b   []byte
+	err error
+}
+
+func (r *persistentReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.b) < n {
+		r.err = io.ErrUnexpectedEOF
+
+		return nil
+	}
+	res := r.b[:n]
+	r.b = r.b[n:]
+
+	return res
+}
+
+func (r *persistentReader) uint32() uint32 {
+	if b := r.next(4); b != nil { //nolint:gomnd
+		return binary.LittleEndian.Uint32(b)
+	}
+
+	return 0
+}
+
+func (r *persistentReader) uint64() uint64 {
+	if b := r.next(8); b != nil { //nolint:gomnd
+		return binary.LittleEndian.Uint64(b)
+	}
+
+	return 0
+}
+
+func (r *persistentReader) bytes() []byte {
+	n := r.uint32()
+
+	return bytes.Clone(r.next(int(n)))
+}
@@ -0,0 +1,246 @@
+package topicwriterinternal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func newTestPersistentMessages(t *testing.T, codec rawtopiccommon.Codec, seqNos ...int64) []messageWithDataContent {
+	messages := make([]messageWithDataContent, 0, len(seqNos))
+	for _, seqNo := range seqNos {
+		msg := newMessageDataWithContent(PublicMessage{
+			SeqNo:     seqNo,
+			CreatedAt: time.Unix(1700000000, seqNo),
+			Data:      bytes.NewReader([]byte{byte(seqNo), 1, 2, 3}),
+			Metadata:  map[string][]byte{"key": {byte(seqNo)}},
+		}, testCommonEncoders)
+		require.NoError(t, msg.CacheMessageData(codec))
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
+
+func testPersistentAcks(seqNos ...int64) []rawtopicwriter.WriteAck {
+	acks := make([]rawtopicwriter.WriteAck, 0, len(seqNos))
+	for _, seqNo := range seqNos {
+		acks = append(acks, rawtopicwriter.WriteAck{SeqNo: seqNo})
+	}
+
+	return acks
+}
+
+func requirePersistentMessages(t *testing.T, expected, actual []messageWithDataContent) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].SeqNo, actual[i].SeqNo)
+		require.True(t, expected[i].CreatedAt.Equal(actual[i].CreatedAt))
+		require.Equal(t, expected[i].Metadata, actual[i].Metadata)
+		require.Equal(t, expected[i].BufUncompressedSize, actual[i].BufUncompressedSize)
+
+		codec := expected[i].bufCodec
+		if expected[i].hasRawContent {
+			codec = rawtopiccommon.CodecRaw
+		}
+		expectedData, err := expected[i].GetEncodedBytes(codec)
+		require.NoError(t, err)
+		actualData, err := actual[i].GetEncodedBytes(codec)
+		require.NoError(t, err)
+		require.Equal(t, expectedData, actualData)
+	}
+}
+
+func TestPersistentQueue(t *testing.T) {
+	t.Run("AppendAckReopen", func(t *testing.T) {
+		dir := t.TempDir()
+		q, messages, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+
+		written := newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2, 3)
+		require.NoError(t, q.Append(written[:2]))
+		require.NoError(t, q.Append(written[2:]))
+		require.NoError(t, q.Ack(testPersistentAcks(2, 10)))
+		require.NoError(t, q.Close())
+
+		q, messages, err = openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		requirePersistentMessages(t, []messageWithDataContent{written[0], written[2]}, messages)
+
+		require.NoError(t, q.Ack(testPersistentAcks(1, 3)))
+		info, err := os.Stat(filepath.Join(dir, persistentQueueFileName))
+		require.NoError(t, err)
+		require.Zero(t, info.Size(), "log must be truncated when all messages acked")
+
+		more := newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 4)
+		require.NoError(t, q.Append(more))
+		require.NoError(t, q.Close())
+
+		_, messages, err = openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		requirePersistentMessages(t, more, messages)
+	})
+	t.Run("EncodedContent", func(t *testing.T) {
+		dir := t.TempDir()
+		q, _, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+
+		written := newTestPersistentMessages(t, rawtopiccommon.CodecGzip, 1)
+		require.NoError(t, q.Append(written))
+		require.NoError(t, q.Close())
+
+		_, messages, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		requirePersistentMessages(t, written, messages)
+		require.Equal(t, rawtopiccommon.CodecGzip, messages[0].bufCodec)
+	})
+	t.Run("TornTail", func(t *testing.T) {
+		dir := t.TempDir()
+		q, _, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+
+		written := newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2)
+		require.NoError(t, q.Append(written))
+		require.NoError(t, q.Close())
+
+		path := filepath.Join(dir, persistentQueueFileName)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, content[:len(content)-1], 0o600))
+
+		q, messages, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		requirePersistentMessages(t, written[:1], messages)
+
+		// new records written after the last valid record
+		require.NoError(t, q.Append(written[1:]))
+		require.NoError(t, q.Close())
+		_, messages, err = openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		requirePersistentMessages(t, written, messages)
+	})
+	t.Run("CorruptedRecord", func(t *testing.T) {
+		// corrupt the last byte of message record with seqno and returns content of log
+		corrupt := func(t *testing.T, dir string, seqNo int64, tail []byte) {
+			q, _, err := openPersistentQueue(dir, testCommonEncoders)
+			require.NoError(t, err)
+			require.NoError(t, q.Append(newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2, 3)))
+			entry := q.entries[seqNo]
+			require.NoError(t, q.Close())
+
+			path := filepath.Join(dir, persistentQueueFileName)
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+			content[entry.offset+entry.size-1]++
+			require.NoError(t, os.WriteFile(path, append(content, tail...), 0o600))
+		}
+
+		t.Run("Last", func(t *testing.T) {
+			dir := t.TempDir()
+			corrupt(t, dir, 3, nil)
+
+			_, messages, err := openPersistentQueue(dir, testCommonEncoders)
+			require.NoError(t, err)
+			requirePersistentMessages(t, newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2), messages)
+		})
+		t.Run("LastWithZeroTail", func(t *testing.T) {
+			dir := t.TempDir()
+			corrupt(t, dir, 3, make([]byte, 100))
+
+			_, messages, err := openPersistentQueue(dir, testCommonEncoders)
+			require.NoError(t, err)
+			requirePersistentMessages(t, newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2), messages)
+		})
+		t.Run("Middle", func(t *testing.T) {
+			dir := t.TempDir()
+			corrupt(t, dir, 2, nil)
+			sizeBefore := xtest.Must(os.Stat(filepath.Join(dir, persistentQueueFileName))).Size()
+
+			_, _, err := openPersistentQueue(dir, testCommonEncoders)
+			require.ErrorIs(t, err, errPersistentQueueCorrupted)
+			require.Equal(t, sizeBefore, xtest.Must(os.Stat(filepath.Join(dir, persistentQueueFileName))).Size(),
+				"log must not be truncated",
+			)
+		})
+	})
+	t.Run("Compact", func(t *testing.T) {
+		dir := t.TempDir()
+		q, _, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+
+		written := newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2, 3, 4)
+		require.NoError(t, q.Append(written))
+		require.NoError(t, q.Ack(testPersistentAcks(1, 3)))
+		sizeBefore := q.size
+
+		q.m.WithLock(func() {
+			require.NoError(t, q.compact())
+		})
+		require.Less(t, q.size, sizeBefore)
+		require.Equal(t, q.size, q.liveSize)
+
+		require.NoError(t, q.Ack(testPersistentAcks(2)))
+		require.NoError(t, q.Close())
+
+		_, messages, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		requirePersistentMessages(t, written[3:], messages)
+	})
+}
+
+func TestWriterReconnectorPersistentQueue(t *testing.T) {
+	t.Run("NeedProducerID", func(t *testing.T) {
+		cfg := NewWriterReconnectorConfig(WithPersistentQueue(t.TempDir()))
+		require.ErrorIs(t, cfg.validate(), errPersistentQueueProducerID)
+
+		cfg = NewWriterReconnectorConfig(WithPersistentQueue(t.TempDir()), WithProducerID("producer"))
+		require.NoError(t, cfg.validate())
+	})
+	t.Run("RestoreAndWrite", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		dir := t.TempDir()
+
+		q, _, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		require.NoError(t, q.Append(newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 5, 6)))
+		require.NoError(t, q.Close())
+
+		w := newTestWriterStopped(WithPersistentQueue(dir), WithAutoSetSeqNo(true))
+		require.NoError(t, w.restorePersistentQueue())
+		require.Equal(t, int64(6), w.lastSeqNo)
+		require.Len(t, w.queue.messagesByOrder, 2)
+
+		w.firstConnectionHandled.Store(true)
+		close(w.firstInitResponseProcessedChan)
+		require.NoError(t, w.Write(ctx, newTestMessages(0)))
+		require.Equal(t, int64(7), w.queue.messagesByOrder[3].SeqNo)
+
+		require.NoError(t, w.queue.AcksReceived(testPersistentAcks(5, 7)))
+		require.NoError(t, w.persistentQueue.Close())
+
+		_, messages, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, int64(6), messages[0].SeqNo)
+	})
+	t.Run("TooManyMessages", func(t *testing.T) {
+		dir := t.TempDir()
+
+		q, _, err := openPersistentQueue(dir, testCommonEncoders)
+		require.NoError(t, err)
+		require.NoError(t, q.Append(newTestPersistentMessages(t, rawtopiccommon.CodecRaw, 1, 2, 3)))
+		require.NoError(t, q.Close())
+
+		w := newTestWriterStopped(WithPersistentQueue(dir), WithMaxQueueLen(2))
+		require.ErrorIs(t, w.restorePersistentQueue(), errPersistentQueueTooManyMessages)
+	})
+}
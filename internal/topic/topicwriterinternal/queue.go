@@ -31,6 +31,7 @@ const (
 
 type messageQueue struct {
 	OnAckReceived func(count int)
+	OnAcks        func(acks []rawtopicwriter.WriteAck)
 
 	hasNewMessages    empty.Chan
 	closedErr         error
@@ -155,6 +156,9 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 		if q.OnAckReceived != nil {
 			q.OnAckReceived(ackReceivedCounter)
 		}
+		if q.OnAcks != nil && ackReceivedCounter > 0 {
+			q.OnAcks(acks[:ackReceivedCounter])
+		}
 	}()
 	if q.closed {
 		return xerrors.WithStackTrace(errAckOnClosedMessageQueue)
@@ -184,6 +188,27 @@ func (q *messageQueue) ackReceivedNeedLock(seqNo int64) error {
 	return nil
 }
 
+// UnackedMessages returns messages without ack from server in write order
+func (q *messageQueue) UnackedMessages() []messageWithDataContent {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	orderIDs := make([]int, 0, len(q.messagesByOrder))
+	for orderID := range q.messagesByOrder {
+		orderIDs = append(orderIDs, orderID)
+	}
+	sort.Slice(orderIDs, func(i, j int) bool {
+		return isFirstCycledIndexLess(orderIDs[i], orderIDs[j])
+	})
+
+	res := make([]messageWithDataContent, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		res = append(res, q.messagesByOrder[orderID])
+	}
+
+	return res
+}
+
 func (q *messageQueue) StopAddNewMessages(reason error) {
 	q.m.Lock()
 	defer q.m.Unlock()
@@ -514,3 +514,20 @@ func getSeqNumbers(messages []messageWithDataContent) []int64 {
 
 	return res
 }
+
+func TestQueue_UnackedMessages(t *testing.T) {
+	t.Run("Simple", func(t *testing.T) {
+		q := newMessageQueue()
+		require.Empty(t, q.UnackedMessages())
+
+		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2, 5)))
+		require.NoError(t, q.AcksReceived([]rawtopicwriter.WriteAck{{SeqNo: 2}}))
+		require.Equal(t, newTestMessagesWithContent(1, 5), q.UnackedMessages())
+	})
+	t.Run("OverflowIndex", func(t *testing.T) {
+		q := newMessageQueue()
+		q.lastWrittenIndex = maxInt - 1
+		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 3, 5)))
+		require.Equal(t, newTestMessagesWithContent(1, 3, 5), q.UnackedMessages())
+	})
+}
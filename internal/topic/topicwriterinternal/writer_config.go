@@ -13,6 +13,8 @@ import (
 
 type WritersCommonConfig struct {
 	producerID          string
+	producerIDGenerated bool
+	persistentQueueDir  string
 	topic               string
 	writerMeta          map[string]string
 	defaultPartitioning rawtopicwriter.Partitioning
@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topiccodecs"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -23,6 +24,10 @@ func WithAddEncoder(codec rawtopiccommon.Codec, encoderFunc PublicCreateEncoderF
 	}
 }
 
+func WithZstdLevel(level int) PublicWriterOption {
+	return WithAddEncoder(rawtopiccommon.CodecZstd, topiccodecs.NewZstdEncoder(level))
+}
+
 func WithAutoSetSeqNo(val bool) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.AutoSetSeqNo = val
@@ -107,9 +112,16 @@ func WithPartitioning(partitioning PublicFuturePartitioning) PublicWriterOption
 	}
 }
 
+func WithPersistentQueue(dir string) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.persistentQueueDir = dir
+	}
+}
+
 func WithProducerID(producerID string) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.producerID = producerID
+		cfg.producerIDGenerated = false
 		oldPartitioningType := cfg.defaultPartitioning.Type
 		if oldPartitioningType == rawtopicwriter.PartitioningUndefined ||
 			oldPartitioningType == rawtopicwriter.PartitioningMessageGroupID {
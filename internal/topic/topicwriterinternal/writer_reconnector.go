@@ -64,6 +64,9 @@ type WriterReconnectorConfig struct {
 	RetrySettings                topic.RetrySettings
 
 	connectTimeout time.Duration
+
+	// onReconnect called before every reconnect of the writer stream
+	onReconnect func()
 }
 
 func (cfg *WriterReconnectorConfig) validate() error {
@@ -71,6 +74,9 @@ func (cfg *WriterReconnectorConfig) validate() error {
 		cfg.producerID != cfg.defaultPartitioning.MessageGroupID {
 		return xerrors.WithStackTrace(errProducerIDNotEqualMessageGroupID)
 	}
+	if cfg.persistentQueueDir != "" && cfg.producerIDGenerated {
+		return xerrors.WithStackTrace(errPersistentQueueProducerID)
+	}
 
 	return nil
 }
@@ -110,6 +116,7 @@ func NewWriterReconnectorConfig(options ...PublicWriterOption) WriterReconnector
 
 	if cfg.producerID == "" {
 		WithProducerID(uuid.NewString())(&cfg)
+		cfg.producerIDGenerated = true
 	}
 
 	return cfg
@@ -125,6 +132,7 @@ type WriterReconnector struct {
 	firstInitResponseProcessedChan empty.Chan
 	lastSeqNo                      int64
 	encodersMap                    *EncoderMap
+	persistentQueue                *persistentQueue
 	initDoneCh                     empty.Chan
 	initInfo                       InitialInfo
 	m                              xsync.RWMutex
@@ -141,6 +149,9 @@ func NewWriterReconnector(
 	}
 
 	res := newWriterReconnectorStopped(cfg)
+	if err := res.restorePersistentQueue(); err != nil {
+		return nil, err
+	}
 	res.start()
 
 	return res, nil
@@ -162,6 +173,7 @@ func newWriterReconnectorStopped(
 	}
 
 	res.queue.OnAckReceived = res.onAckReceived
+	res.queue.OnAcks = res.onAcks
 
 	for codec, creator := range cfg.AdditionalEncoders {
 		res.encodersMap.AddEncoder(codec, creator)
@@ -205,12 +217,49 @@ func (w *WriterReconnector) fillFields(messages []messageWithDataContent) error
 	return nil
 }
 
+// restorePersistentQueue opens persistent queue (if configured) and adds unacked messages
+// from previous runs to the queue. It must be called before start.
+func (w *WriterReconnector) restorePersistentQueue() error {
+	if w.cfg.persistentQueueDir == "" {
+		return nil
+	}
+
+	persistent, messages, err := openPersistentQueue(w.cfg.persistentQueueDir, w.encodersMap)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) > 0 {
+		if !w.semaphore.TryAcquire(int64(len(messages))) {
+			_ = persistent.Close()
+
+			return xerrors.WithStackTrace(fmt.Errorf("%w: unacked messages %v, max queue len %v",
+				errPersistentQueueTooManyMessages, len(messages), w.cfg.MaxQueueLen,
+			))
+		}
+		if err = w.queue.AddMessages(messages); err != nil {
+			_ = persistent.Close()
+
+			return err
+		}
+		w.lastSeqNo = messages[len(messages)-1].SeqNo
+	}
+	w.persistentQueue = persistent
+
+	return nil
+}
+
 func (w *WriterReconnector) start() {
 	name := fmt.Sprintf("writer %q", w.cfg.topic)
 	w.background.Start(name+", sendloop", w.connectionLoop)
 }
 
-func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage) (resErr error) {
+func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage) error {
+	return w.write(ctx, "", messages)
+}
+
+// write messages with routing key of multi writer
+func (w *WriterReconnector) write(ctx context.Context, key string, messages []PublicMessage) (resErr error) {
 	if err := w.background.CloseReason(); err != nil {
 		return xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
 	}
@@ -220,6 +269,9 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 	if len(messages) == 0 {
 		return nil
 	}
+	if w.persistentQueue != nil && messages[0].tx != nil {
+		return xerrors.WithStackTrace(errPersistentQueueTransaction)
+	}
 
 	semaphoreWeight := int64(len(messages))
 	if semaphoreWeight > int64(w.cfg.MaxQueueLen) {
@@ -246,6 +298,9 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 	if err != nil {
 		return err
 	}
+	for i := range messagesSlice {
+		messagesSlice[i].routingKey = key
+	}
 
 	if err = w.checkMessages(messagesSlice); err != nil {
 		return err
@@ -272,6 +327,52 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 	return w.queue.Wait(ctx, waiter)
 }
 
+// writeDrained adds unacked messages of other writer to the queue.
+// Messages keep their content and created time, seqno set again for auto set seqno mode.
+func (w *WriterReconnector) writeDrained(ctx context.Context, messages []messageWithDataContent) error {
+	if err := w.background.CloseReason(); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	semaphoreWeight := int64(len(messages))
+	if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		w.semaphore.Release(semaphoreWeight)
+	}()
+
+	if err := w.waitFirstInitResponse(ctx); err != nil {
+		return err
+	}
+
+	var err error
+	w.m.WithLock(func() {
+		if w.cfg.AutoSetSeqNo {
+			for i := range messages {
+				w.lastSeqNo++
+				messages[i].SeqNo = w.lastSeqNo
+			}
+		}
+
+		if w.persistentQueue != nil {
+			if err = w.persistentQueue.Append(messages); err != nil {
+				return
+			}
+		}
+
+		if err = w.queue.AddMessages(messages); err == nil {
+			// move semaphore weight to queue
+			semaphoreWeight = 0
+		}
+	})
+
+	return err
+}
+
 func (w *WriterReconnector) addMessageToInternalQueueWithLock(
 	messagesSlice []messageWithDataContent,
 	semaphoreWeight *int64,
@@ -287,6 +388,12 @@ func (w *WriterReconnector) addMessageToInternalQueueWithLock(
 			return
 		}
 
+		if w.persistentQueue != nil {
+			if err = w.persistentQueue.Append(messagesSlice); err != nil {
+				return
+			}
+		}
+
 		if w.cfg.WaitServerAck {
 			waiter, err = w.queue.AddMessagesWithWaiter(messagesSlice)
 		} else {
@@ -364,6 +471,27 @@ func (w *WriterReconnector) Close(ctx context.Context) error {
 	return closeErr
 }
 
+// drain closes the writer without wait acks and returns unacked messages in write order.
+// The messages stay in persistent queue of the writer, they must be removed after write by other writer.
+func (w *WriterReconnector) drain(ctx context.Context) (messages []messageWithDataContent, resErr error) {
+	reason := xerrors.WithStackTrace(errStopWriterReconnector)
+	w.queue.StopAddNewMessages(reason)
+
+	onDone := trace.TopicOnWriterClose(w.cfg.Tracer, w.writerInstanceID, reason)
+	defer func() {
+		onDone(resErr)
+	}()
+
+	// stop background work before take messages, no acks will be received after it
+	if err := w.background.Close(ctx, reason); err != nil {
+		return nil, err
+	}
+
+	messages = w.queue.UnackedMessages()
+
+	return messages, w.closeQueues(reason)
+}
+
 func (w *WriterReconnector) close(ctx context.Context, reason error) (resErr error) {
 	onDone := trace.TopicOnWriterClose(w.cfg.Tracer, w.writerInstanceID, reason)
 	defer func() {
@@ -376,14 +504,25 @@ func (w *WriterReconnector) close(ctx context.Context, reason error) (resErr err
 		resErr = bgErr
 	}
 
-	closeErr := w.queue.Close(reason)
-	if resErr == nil && closeErr != nil {
+	if closeErr := w.closeQueues(reason); resErr == nil && closeErr != nil {
 		resErr = closeErr
 	}
 
 	return resErr
 }
 
+func (w *WriterReconnector) closeQueues(reason error) (resErr error) {
+	resErr = w.queue.Close(reason)
+
+	if w.persistentQueue != nil {
+		if err := w.persistentQueue.Close(); resErr == nil && err != nil {
+			resErr = err
+		}
+	}
+
+	return resErr
+}
+
 func (w *WriterReconnector) connectionLoop(ctx context.Context) {
 	attempt := 0
 
@@ -419,6 +558,9 @@ func (w *WriterReconnector) connectionLoop(ctx context.Context) {
 		prevAttemptTime = now
 
 		if reconnectReason != nil {
+			if w.cfg.onReconnect != nil {
+				w.cfg.onReconnect()
+			}
 			if w.handleReconnectRetry(ctx, reconnectReason, attempt, startOfRetries) {
 				return
 			}
@@ -543,6 +685,13 @@ func (w *WriterReconnector) onAckReceived(count int) {
 	w.semaphore.Release(int64(count))
 }
 
+func (w *WriterReconnector) onAcks(acks []rawtopicwriter.WriteAck) {
+	if w.persistentQueue != nil {
+		// error of ack save is not fatal: the messages will be replayed and skipped by server as duplicates
+		_ = w.persistentQueue.Ack(acks)
+	}
+}
+
 func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 	isFirstInit := false
 	w.m.WithLock(func() {
@@ -559,7 +708,8 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 		defer close(w.firstInitResponseProcessedChan)
 		isFirstInit = true
 
-		if writerStream.LastSeqNumRequested {
+		// messages restored from persistent queue may be not written to server yet
+		if writerStream.LastSeqNumRequested && writerStream.ReceivedLastSeqNum > w.lastSeqNo {
 			w.lastSeqNo = writerStream.ReceivedLastSeqNum
 		}
 	})
@@ -0,0 +1,73 @@
+package value
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var primitiveGoTypes = map[types.Primitive]reflect.Type{
+	types.Bool:         reflect.TypeOf(false),
+	types.Int8:         reflect.TypeOf(int8(0)),
+	types.Uint8:        reflect.TypeOf(uint8(0)),
+	types.Int16:        reflect.TypeOf(int16(0)),
+	types.Uint16:       reflect.TypeOf(uint16(0)),
+	types.Int32:        reflect.TypeOf(int32(0)),
+	types.Uint32:       reflect.TypeOf(uint32(0)),
+	types.Int64:        reflect.TypeOf(int64(0)),
+	types.Uint64:       reflect.TypeOf(uint64(0)),
+	types.Float:        reflect.TypeOf(float32(0)),
+	types.Double:       reflect.TypeOf(float64(0)),
+	types.Date:         reflect.TypeOf(time.Time{}),
+	types.Datetime:     reflect.TypeOf(time.Time{}),
+	types.Timestamp:    reflect.TypeOf(time.Time{}),
+	types.Interval:     reflect.TypeOf(time.Duration(0)),
+	types.TzDate:       reflect.TypeOf(""),
+	types.TzDatetime:   reflect.TypeOf(""),
+	types.TzTimestamp:  reflect.TypeOf(""),
+	types.Bytes:        reflect.TypeOf([]byte{}),
+	types.Text:         reflect.TypeOf(""),
+	types.YSON:         reflect.TypeOf([]byte{}),
+	types.JSON:         reflect.TypeOf([]byte{}),
+	types.UUID:         reflect.TypeOf([16]byte{}),
+	types.JSONDocument: reflect.TypeOf([]byte{}),
+	types.DyNumber:     reflect.TypeOf(""),
+}
+
+// Any converts value to go value in the same manner as table service scanner:
+// primitive values are converted to go types, NULL is converted to nil and other values
+// (containers, decimals) are returned as is
+func Any(v Value) (interface{}, error) {
+	v = Unwrap(v)
+	if v == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	p, isPrimitive := v.Type().(types.Primitive)
+	if !isPrimitive {
+		return v, nil
+	}
+
+	goType, has := primitiveGoTypes[p]
+	if !has {
+		return v, nil
+	}
+
+	dst := reflect.New(goType)
+	if err := CastTo(v, dst.Interface()); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	switch p {
+	case types.TzDate:
+		return TzDateToTime(dst.Elem().String())
+	case types.TzDatetime:
+		return TzDatetimeToTime(dst.Elem().String())
+	case types.TzTimestamp:
+		return TzTimestampToTime(dst.Elem().String())
+	default:
+		return dst.Elem().Interface(), nil
+	}
+}
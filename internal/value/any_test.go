@@ -0,0 +1,91 @@
+package value
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestAny(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		src  Value
+		dst  interface{}
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			src:  BoolValue(true),
+			dst:  true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  Int32Value(42),
+			dst:  int32(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  Uint64Value(42),
+			dst:  uint64(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  DoubleValue(42.5),
+			dst:  42.5,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  TextValue("test"),
+			dst:  "test",
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  BytesValue([]byte("test")),
+			dst:  []byte("test"),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  JSONValue(`{"a":1}`),
+			dst:  []byte(`{"a":1}`),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  IntervalValueFromDuration(time.Second),
+			dst:  time.Second,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  TimestampValueFromTime(time.Unix(1, 0)),
+			dst:  time.Unix(1, 0),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  TzDatetimeValue("2024-01-02T03:04:05,UTC"),
+			dst:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  OptionalValue(Int64Value(42)),
+			dst:  int64(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  NullValue(types.Int64),
+			dst:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  ListValue(Int64Value(1), Int64Value(2)),
+			dst:  ListValue(Int64Value(1), Int64Value(2)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dst, err := Any(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.dst, dst)
+		})
+	}
+}
@@ -575,6 +575,11 @@ type (
 	}
 )
 
+// Fields returns key-value pairs of dict in original order
+func (v *dictValue) Fields() []DictValueField {
+	return v.values
+}
+
 func (v *dictValue) DictValues() map[Value]Value {
 	values := make(map[Value]Value, len(v.values))
 	for i := range v.values {
@@ -1314,6 +1319,10 @@ type setValue struct {
 	items []Value
 }
 
+func (v *setValue) SetItems() []Value {
+	return v.items
+}
+
 func (v *setValue) castTo(dst interface{}) error {
 	return xerrors.WithStackTrace(fmt.Errorf(
 		"%w '%+v' to '%T' destination",
@@ -1434,6 +1443,15 @@ func (v *optionalValue) castTo(dst interface{}) error {
 	return nil
 }
 
+// Unwrap returns inner value for optional value (nil for NULL) or given value as is for non-optional value
+func Unwrap(v Value) Value {
+	if vv, ok := v.(*optionalValue); ok {
+		return vv.value
+	}
+
+	return v
+}
+
 func (v *optionalValue) Yql() string {
 	if v.value == nil {
 		return fmt.Sprintf("Nothing(%s)", v.Type().Yql())
@@ -1490,6 +1508,11 @@ func (v *structValue) StructFields() map[string]Value {
 	return fields
 }
 
+// Fields returns fields of struct value in order of struct type
+func (v *structValue) Fields() []StructValueField {
+	return v.fields
+}
+
 func (v *structValue) castTo(dst interface{}) error {
 	return xerrors.WithStackTrace(fmt.Errorf(
 		"%w '%+v' to '%T' destination",
@@ -2014,11 +2037,11 @@ type uint64Value uint64
 func (v uint64Value) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
 	case *string:
-		*vv = strconv.FormatInt(int64(v), 10)
+		*vv = strconv.FormatUint(uint64(v), 10)
 
 		return nil
 	case *[]byte:
-		*vv = xstring.ToBytes(strconv.FormatInt(int64(v), 10))
+		*vv = xstring.ToBytes(strconv.FormatUint(uint64(v), 10))
 
 		return nil
 	case *uint64:
@@ -2438,7 +2461,7 @@ func ZeroValue(t types.Type) Value {
 		}
 	case *types.Dict:
 		return &dictValue{
-			t: t.ValueType(),
+			t: t,
 		}
 	case *types.EmptyDict:
 		return &dictValue{
@@ -1089,6 +1089,12 @@ func TestCastOtherTypes(t *testing.T) {
 		result interface{}
 		error  bool
 	}{
+		{
+			v:      Uint64Value(math.MaxUint64),
+			dst:    func(v string) *string { return &v }(""),
+			result: func(v string) *string { return &v }("18446744073709551615"),
+			error:  false,
+		},
 		{
 			v:      BytesValue([]byte("test")),
 			dst:    func(v []byte) *[]byte { return &v }(make([]byte, 0, 10)),
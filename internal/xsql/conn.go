@@ -18,6 +18,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
@@ -74,6 +75,14 @@ type conn struct {
 	trace     *trace.DatabaseSQL
 	session   table.ClosableSession // Immutable and r/o usage.
 
+	// querySession is defined instead of session for connections over query service
+	querySession interface {
+		query.Session
+
+		IsAlive() bool
+		Close(ctx context.Context) error
+	}
+
 	beginTxFuncs map[QueryMode]beginTxFunc
 
 	closed           atomic.Bool
@@ -147,9 +156,39 @@ func newConn(ctx context.Context, c *Connector, s table.ClosableSession, opts ..
 }
 
 func (c *conn) isReady() bool {
+	if c.querySession != nil {
+		return c.querySession.IsAlive()
+	}
+
 	return c.session.Status() == table.SessionReady
 }
 
+func (c *conn) closeSession(ctx context.Context) error {
+	if c.querySession != nil {
+		return c.querySession.Close(ctx)
+	}
+
+	return c.session.Close(ctx)
+}
+
+func (c *conn) describeTable(ctx context.Context, tableName string) (desc options.Description, _ error) {
+	if c.querySession != nil {
+		// query service has no describe table call, so table service is used for metadata requests
+		err := c.connector.parent.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			desc, err = s.DescribeTable(ctx, tableName)
+
+			return err
+		}, table.WithIdempotent())
+		if err != nil {
+			return desc, xerrors.WithStackTrace(err)
+		}
+
+		return desc, nil
+	}
+
+	return c.session.DescribeTable(ctx, tableName)
+}
+
 func (c *conn) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, finalErr error) {
 	if c.currentTx != nil {
 		return c.currentTx.PrepareContext(ctx, query)
@@ -206,6 +245,10 @@ func (c *conn) execContext(
 		onDone(finalErr)
 	}()
 
+	if c.querySession != nil {
+		return c.queryServiceExec(ctx, m, query, args)
+	}
+
 	switch m {
 	case DataQueryMode:
 		return c.executeDataQuery(ctx, query, args)
@@ -336,6 +379,10 @@ func (c *conn) queryContext(ctx context.Context, query string, args []driver.Nam
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	if c.querySession != nil {
+		return c.queryServiceQuery(ctx, queryMode, normalizedQuery, parameters)
+	}
+
 	switch queryMode {
 	case DataQueryMode:
 		return c.execDataQuery(ctx, normalizedQuery, parameters)
@@ -424,6 +471,10 @@ func (c *conn) Ping(ctx context.Context) (finalErr error) {
 	if !c.isReady() {
 		return badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
+	if c.querySession != nil {
+		// liveness of query service session is tracked by attach stream
+		return nil
+	}
 	if err := c.session.KeepAlive(ctx); err != nil {
 		return badconn.Map(xerrors.WithStackTrace(err))
 	}
@@ -447,7 +498,7 @@ func (c *conn) Close() (finalErr error) {
 		if c.currentTx != nil {
 			_ = c.currentTx.Rollback()
 		}
-		err := c.session.Close(xcontext.ValueOnly(ctx))
+		err := c.closeSession(xcontext.ValueOnly(ctx))
 		if err != nil {
 			return badconn.Map(xerrors.WithStackTrace(err))
 		}
@@ -477,6 +528,10 @@ func (c *conn) normalize(q string, args ...driver.NamedValue) (query string, _ p
 }
 
 func (c *conn) ID() string {
+	if c.querySession != nil {
+		return c.querySession.ID()
+	}
+
 	return c.session.ID()
 }
 
@@ -564,7 +619,7 @@ func (c *conn) IsColumnExists(ctx context.Context, tableName, columnName string)
 	}
 
 	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
+		desc, err := c.describeTable(ctx, tableName)
 		if err != nil {
 			return err
 		}
@@ -599,7 +654,7 @@ func (c *conn) GetColumns(ctx context.Context, tableName string) (columns []stri
 	}
 
 	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
+		desc, err := c.describeTable(ctx, tableName)
 		if err != nil {
 			return err
 		}
@@ -638,7 +693,7 @@ func (c *conn) GetColumnType(ctx context.Context, tableName, columnName string)
 	}
 
 	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
+		desc, err := c.describeTable(ctx, tableName)
 		if err != nil {
 			return err
 		}
@@ -673,7 +728,7 @@ func (c *conn) GetPrimaryKeys(ctx context.Context, tableName string) (pkCols []s
 	}
 
 	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
+		desc, err := c.describeTable(ctx, tableName)
 		if err != nil {
 			return err
 		}
@@ -830,7 +885,7 @@ func (c *conn) GetIndexes(ctx context.Context, tableName string) (indexes []stri
 	}
 
 	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
+		desc, err := c.describeTable(ctx, tableName)
 		if err != nil {
 			return err
 		}
@@ -874,7 +929,7 @@ func (c *conn) GetIndexColumns(ctx context.Context, tableName, indexName string)
 	}
 
 	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
+		desc, err := c.describeTable(ctx, tableName)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,165 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	queryTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func newQueryConn(ctx context.Context, c *Connector, s *internalQuery.Session, opts ...connOption) *conn {
+	cc := &conn{
+		ctx:          ctx,
+		connector:    c,
+		querySession: s,
+	}
+	cc.beginTxFuncs = map[QueryMode]beginTxFunc{
+		DataQueryMode: cc.beginQueryTx,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cc)
+		}
+	}
+	c.attach(cc)
+
+	return cc
+}
+
+func (c *conn) queryServiceExec(
+	ctx context.Context, m QueryMode, q string, args []driver.NamedValue,
+) (driver.Result, error) {
+	normalizedQuery, parameters, err := c.normalize(q, args...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	switch m {
+	case DataQueryMode:
+		err = c.querySession.Exec(ctx, normalizedQuery,
+			query.WithTxControl(queryTxControl(ctx, c.defaultTxControl)),
+			query.WithParameters(&parameters),
+		)
+	case SchemeQueryMode, ScriptingQueryMode:
+		err = c.querySession.Exec(ctx, normalizedQuery,
+			query.WithTxControl(query.NoTx()),
+			query.WithParameters(&parameters),
+		)
+	default:
+		return nil, fmt.Errorf("unsupported query mode '%s' for execute query", m)
+	}
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return resultNoRows{}, nil
+}
+
+func (c *conn) queryServiceQuery(
+	ctx context.Context, m QueryMode, q string, parameters params.Parameters,
+) (driver.Rows, error) {
+	switch m {
+	case DataQueryMode, ScanQueryMode:
+		res, err := c.querySession.Query(ctx, q,
+			query.WithTxControl(queryTxControl(ctx, c.defaultTxControl)),
+			query.WithParameters(&parameters),
+		)
+		if err != nil {
+			return nil, badconn.Map(xerrors.WithStackTrace(err))
+		}
+
+		return newQueryRows(ctx, c, res), nil
+	case ScriptingQueryMode:
+		res, err := c.querySession.Query(ctx, q,
+			query.WithTxControl(query.NoTx()),
+			query.WithParameters(&parameters),
+		)
+		if err != nil {
+			return nil, badconn.Map(xerrors.WithStackTrace(err))
+		}
+
+		return newQueryRows(ctx, c, res), nil
+	case ExplainQueryMode:
+		return c.queryServiceExplain(ctx, q, parameters)
+	default:
+		return nil, fmt.Errorf("unsupported query mode '%s' on conn query", m)
+	}
+}
+
+func (c *conn) queryServiceExplain(
+	ctx context.Context, q string, parameters params.Parameters,
+) (driver.Rows, error) {
+	var ast, plan string
+	err := c.querySession.Exec(ctx, q,
+		query.WithExecMode(query.ExecModeExplain),
+		query.WithStatsMode(query.StatsModeNone, func(stats query.Stats) {
+			ast = stats.QueryAST()
+			plan = stats.QueryPlan()
+		}),
+		query.WithParameters(&parameters),
+	)
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return &single{
+		values: []sql.NamedArg{
+			sql.Named("AST", ast),
+			sql.Named("Plan", plan),
+		},
+	}, nil
+}
+
+// queryTxControl converts table transaction control from context or connector default (if defined)
+// to query service transaction control. Without any transaction control the query service chooses
+// transaction mode on server-side
+func queryTxControl(ctx context.Context, defaultTxControl *table.TransactionControl) *query.TransactionControl {
+	txc := txControl(ctx, defaultTxControl)
+	if txc == nil {
+		return query.DefaultTxControl()
+	}
+
+	var opts []queryTx.ControlOption
+
+	switch selector := txc.Desc().GetTxSelector().(type) {
+	case *Ydb_Table.TransactionControl_TxId:
+		opts = append(opts, query.WithTxID(selector.TxId))
+	case *Ydb_Table.TransactionControl_BeginTx:
+		opts = append(opts, query.BeginTx(queryTxSettings(selector.BeginTx)...))
+	}
+
+	if txc.Desc().GetCommitTx() {
+		opts = append(opts, query.CommitTx())
+	}
+
+	return query.TxControl(opts...)
+}
+
+func queryTxSettings(settings *Ydb_Table.TransactionSettings) []query.TransactionOption {
+	switch mode := settings.GetTxMode().(type) {
+	case *Ydb_Table.TransactionSettings_SerializableReadWrite:
+		return []query.TransactionOption{query.WithSerializableReadWrite()}
+	case *Ydb_Table.TransactionSettings_SnapshotReadOnly:
+		return []query.TransactionOption{query.WithSnapshotReadOnly()}
+	case *Ydb_Table.TransactionSettings_StaleReadOnly:
+		return []query.TransactionOption{query.WithStaleReadOnly()}
+	case *Ydb_Table.TransactionSettings_OnlineReadOnly:
+		if mode.OnlineReadOnly.GetAllowInconsistentReads() {
+			return []query.TransactionOption{query.WithOnlineReadOnly(query.WithInconsistentReads())}
+		}
+
+		return []query.TransactionOption{query.WithOnlineReadOnly()}
+	default:
+		return []query.TransactionOption{query.WithDefaultTxMode()}
+	}
+}
@@ -0,0 +1,140 @@
+package xsql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func TestQueryTxControl(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		ctx              context.Context //nolint:containedctx
+		defaultTxControl *table.TransactionControl
+		query            *query.TransactionControl
+	}{
+		{
+			name:  xtest.CurrentFileLine(),
+			ctx:   context.Background(),
+			query: query.NoTx(),
+		},
+		{
+			name:             xtest.CurrentFileLine(),
+			ctx:              context.Background(),
+			defaultTxControl: table.SnapshotReadOnlyTxControl(),
+			query:            query.SnapshotReadOnlyTxControl(),
+		},
+		{
+			name:             xtest.CurrentFileLine(),
+			ctx:              WithTxControl(context.Background(), table.StaleReadOnlyTxControl()),
+			defaultTxControl: table.SnapshotReadOnlyTxControl(),
+			query:            query.StaleReadOnlyTxControl(),
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			ctx:   WithTxControl(context.Background(), table.DefaultTxControl()),
+			query: query.SerializableReadWriteTxControl(query.CommitTx()),
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			ctx:   WithTxControl(context.Background(), table.SnapshotReadOnlyTxControl()),
+			query: query.SnapshotReadOnlyTxControl(),
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			ctx:   WithTxControl(context.Background(), table.StaleReadOnlyTxControl()),
+			query: query.StaleReadOnlyTxControl(),
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			ctx:   WithTxControl(context.Background(), table.OnlineReadOnlyTxControl(table.WithInconsistentReads())),
+			query: query.OnlineReadOnlyTxControl(query.WithInconsistentReads()),
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			ctx:   WithTxControl(context.Background(), table.TxControl(table.WithTxID("test"))),
+			query: query.TxControl(query.WithTxID("test")),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+			require.Equal(t,
+				tt.query.ToYDB(a).String(),
+				queryTxControl(tt.ctx, tt.defaultTxControl).ToYDB(a).String(),
+			)
+		})
+	}
+}
+
+type querySessionStub struct {
+	query.Session
+
+	opts []options.Execute
+}
+
+func (s *querySessionStub) Exec(ctx context.Context, q string, opts ...options.Execute) error {
+	s.opts = opts
+
+	return nil
+}
+
+func (s *querySessionStub) IsAlive() bool {
+	return true
+}
+
+func (s *querySessionStub) Close(ctx context.Context) error {
+	return nil
+}
+
+func TestConnQueryServiceExecTxControl(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		ctx              context.Context //nolint:containedctx
+		defaultTxControl *table.TransactionControl
+		query            *query.TransactionControl
+	}{
+		{
+			name:             xtest.CurrentFileLine(),
+			ctx:              context.Background(),
+			defaultTxControl: table.DefaultTxControl(),
+			query:            query.SerializableReadWriteTxControl(query.CommitTx()),
+		},
+		{
+			name:             xtest.CurrentFileLine(),
+			ctx:              context.Background(),
+			defaultTxControl: table.OnlineReadOnlyTxControl(),
+			query:            query.OnlineReadOnlyTxControl(),
+		},
+		{
+			name:             xtest.CurrentFileLine(),
+			ctx:              WithTxControl(context.Background(), table.SnapshotReadOnlyTxControl()),
+			defaultTxControl: table.OnlineReadOnlyTxControl(),
+			query:            query.SnapshotReadOnlyTxControl(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &querySessionStub{}
+			c := &conn{
+				connector:        &Connector{},
+				querySession:     s,
+				defaultTxControl: tt.defaultTxControl,
+			}
+			_, err := c.queryServiceExec(tt.ctx, DataQueryMode, "SELECT 1", nil)
+			require.NoError(t, err)
+			a := allocator.New()
+			defer a.Free()
+			require.Equal(t,
+				tt.query.ToYDB(a).String(),
+				options.ExecuteSettings(s.opts...).TxControl().ToYDB(a).String(),
+			)
+		})
+	}
+}
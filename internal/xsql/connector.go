@@ -11,6 +11,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -138,6 +139,20 @@ func WithDisableServerBalancer() ConnectorOption {
 	return disableServerBalancerConnectorOption{}
 }
 
+type queryServiceConnectorOption bool
+
+func (enabled queryServiceConnectorOption) Apply(c *Connector) error {
+	c.queryService = bool(enabled)
+
+	return nil
+}
+
+// WithQueryService enables or disables execution of database/sql queries over query service
+// instead of table service
+func WithQueryService(enabled bool) ConnectorOption {
+	return queryServiceConnectorOption(enabled)
+}
+
 type idleThresholdConnectorOption time.Duration
 
 func (idleThreshold idleThresholdConnectorOption) Apply(c *Connector) error {
@@ -206,6 +221,7 @@ func WithFakeTx(m QueryMode) ConnectorOption {
 type ydbDriver interface {
 	Name() string
 	Table() table.Client
+	Query() *internalQuery.Client
 	Scripting() scripting.Client
 	Scheme() scheme.Client
 }
@@ -261,6 +277,7 @@ type Connector struct {
 	defaultDataQueryOpts  []options.ExecuteDataQueryOption
 	defaultScanQueryOpts  []options.ExecuteScanQueryOption
 	disableServerBalancer bool
+	queryService          bool
 	idleThreshold         time.Duration
 
 	trace       *trace.DatabaseSQL
@@ -294,7 +311,7 @@ func (c *Connector) idleCloser() (idleStopper func()) {
 				c.connsMtx.RUnlock()
 				for _, cc := range conns {
 					if cc.sinceLastUsage() > c.idleThreshold {
-						cc.session.Close(context.Background())
+						_ = cc.closeSession(context.Background())
 					}
 				}
 			}
@@ -329,32 +346,48 @@ func (c *Connector) detach(cc *conn) {
 	delete(c.conns, cc)
 }
 
-func (c *Connector) Connect(ctx context.Context) (_ driver.Conn, err error) {
+func (c *Connector) Connect(ctx context.Context) (_ driver.Conn, finalErr error) {
 	var (
 		onDone = trace.DatabaseSQLOnConnectorConnect(
 			c.trace, &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*Connector).Connect"),
 		)
-		session table.ClosableSession
+		session interface {
+			ID() string
+			NodeID() uint32
+			Status() string
+		}
 	)
 	defer func() {
-		onDone(err, session)
+		onDone(finalErr, session)
 	}()
 	if !c.disableServerBalancer {
 		ctx = meta.WithAllowFeatures(ctx, metaHeaders.HintSessionBalancer)
 	}
-	session, err = c.parent.Table().CreateSession(ctx) //nolint
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	return newConn(ctx, c, session, withDefaultTxControl(c.defaultTxControl),
+	opts := []connOption{
+		withDefaultTxControl(c.defaultTxControl),
 		withDefaultQueryMode(c.defaultQueryMode),
 		withDataOpts(c.defaultDataQueryOpts...),
 		withScanOpts(c.defaultScanQueryOpts...),
 		withTrace(c.trace),
 		withFakeTxModes(c.fakeTxModes...),
-	), nil
+	}
+	if c.queryService {
+		s, err := internalQuery.CreateSession(ctx, c.parent.Query())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		session = s
+
+		return newQueryConn(ctx, c, s, opts...), nil
+	}
+	s, err := c.parent.Table().CreateSession(ctx) //nolint
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	session = s
+
+	return newConn(ctx, c, s, opts...), nil
 }
 
 func (c *Connector) Driver() driver.Driver {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
@@ -30,3 +31,24 @@ func ToYDB(opts driver.TxOptions) (txcControl table.TxOption, err error) {
 		"unsupported transaction options: %+v", opts,
 	))
 }
+
+// ToQueryTxSettings maps driver transaction options to query service transaction settings option.
+// Supported options are the same as for ToYDB.
+// It returns error on unsupported options.
+func ToQueryTxSettings(opts driver.TxOptions) (txSettings query.TransactionOption, err error) {
+	level := sql.IsolationLevel(opts.Isolation)
+	switch level {
+	case sql.LevelDefault, sql.LevelSerializable:
+		if !opts.ReadOnly {
+			return query.WithSerializableReadWrite(), nil
+		}
+	case sql.LevelSnapshot:
+		if opts.ReadOnly {
+			return query.WithSnapshotReadOnly(), nil
+		}
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf(
+		"unsupported transaction options: %+v", opts,
+	))
+}
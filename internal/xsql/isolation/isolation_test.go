@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
@@ -164,3 +165,74 @@ func TestToYDB(t *testing.T) {
 		})
 	}
 }
+
+func TestToQueryTxSettings(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		txOptions  driver.TxOptions
+		txSettings query.TransactionOption
+		err        bool
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelDefault),
+				ReadOnly:  false,
+			},
+			txSettings: query.WithSerializableReadWrite(),
+			err:        false,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSerializable),
+				ReadOnly:  false,
+			},
+			txSettings: query.WithSerializableReadWrite(),
+			err:        false,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSnapshot),
+				ReadOnly:  true,
+			},
+			txSettings: query.WithSnapshotReadOnly(),
+			err:        false,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSnapshot),
+				ReadOnly:  false,
+			},
+			err: true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelReadCommitted),
+				ReadOnly:  true,
+			},
+			err: true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelDefault),
+				ReadOnly:  true,
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			txSettings, err := ToQueryTxSettings(tt.txOptions)
+			if !tt.err {
+				require.NoError(t, err)
+				require.Equal(t, tt.txSettings, txSettings)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
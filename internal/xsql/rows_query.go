@@ -0,0 +1,172 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+var (
+	_ driver.Rows                           = &queryRows{}
+	_ driver.RowsNextResultSet              = &queryRows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &queryRows{}
+	_ driver.RowsColumnTypeNullable         = &queryRows{}
+)
+
+// queryRows is a driver.Rows over the stream result of query service
+type queryRows struct {
+	conn   *conn
+	ctx    context.Context //nolint:containedctx
+	result query.Result
+
+	resultSet query.ResultSet
+	// columns contains indexes of result set columns which are visible for database/sql
+	columns []int
+
+	// next result set may be fetched before it's usage for answer on HasNextResultSet
+	nextResultSet    query.ResultSet
+	nextResultSetErr error
+	nextFetched      bool
+}
+
+func newQueryRows(ctx context.Context, c *conn, res query.Result) *queryRows {
+	return &queryRows{
+		conn:   c,
+		ctx:    ctx,
+		result: res,
+	}
+}
+
+func (r *queryRows) fetchNextResultSet() {
+	if !r.nextFetched {
+		r.nextResultSet, r.nextResultSetErr = r.result.NextResultSet(r.ctx)
+		r.nextFetched = true
+	}
+}
+
+func (r *queryRows) switchResultSet() error {
+	r.fetchNextResultSet()
+	r.nextFetched = false
+	if r.nextResultSetErr != nil {
+		return r.nextResultSetErr
+	}
+	r.resultSet = r.nextResultSet
+	r.columns = r.columns[:0]
+	for i, name := range r.resultSet.Columns() {
+		if !strings.HasPrefix(name, ignoreColumnPrefixName) {
+			r.columns = append(r.columns, i)
+		}
+	}
+
+	return nil
+}
+
+// firstResultSet switches rows to the first result set as default.
+// Iterate over many result sets must be with rows.NextResultSet()
+func (r *queryRows) firstResultSet() error {
+	if r.resultSet != nil {
+		return nil
+	}
+
+	return r.switchResultSet()
+}
+
+func (r *queryRows) Columns() []string {
+	if err := r.firstResultSet(); err != nil {
+		return nil
+	}
+	names := r.resultSet.Columns()
+	cs := make([]string, 0, len(r.columns))
+	for _, i := range r.columns {
+		cs = append(cs, names[i])
+	}
+
+	return cs
+}
+
+func (r *queryRows) ColumnTypeDatabaseTypeName(index int) string {
+	if err := r.firstResultSet(); err != nil {
+		return ""
+	}
+
+	return r.resultSet.ColumnTypes()[r.columns[index]].Yql()
+}
+
+func (r *queryRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	if err := r.firstResultSet(); err != nil {
+		return false, false
+	}
+	_, nullable = r.resultSet.ColumnTypes()[r.columns[index]].(interface {
+		IsOptional()
+	})
+
+	return nullable, true
+}
+
+func (r *queryRows) NextResultSet() error {
+	if r.resultSet == nil {
+		// skip the first result set which was not touched
+		if err := r.switchResultSet(); err != nil {
+			return badconn.Map(xerrors.WithStackTrace(err))
+		}
+	}
+	if err := r.switchResultSet(); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return nil
+}
+
+func (r *queryRows) HasNextResultSet() bool {
+	if err := r.firstResultSet(); err != nil {
+		return false
+	}
+	r.fetchNextResultSet()
+
+	return r.nextResultSetErr == nil
+}
+
+func (r *queryRows) Next(dst []driver.Value) error {
+	if err := r.firstResultSet(); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	row, err := r.resultSet.NextRow(r.ctx)
+	if err != nil {
+		if xerrors.Is(err, io.EOF) {
+			return io.EOF
+		}
+
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	values := make([]value.Value, len(r.resultSet.Columns()))
+	refs := make([]interface{}, len(values))
+	for i := range values {
+		refs[i] = &values[i]
+	}
+	if err = row.Scan(refs...); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	for i := range dst {
+		dst[i], err = driverValue(values[r.columns[i]])
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *queryRows) Close() error {
+	return r.result.Close(r.ctx)
+}
+
+// driverValue converts value to driver value in the same manner as table service rows
+func driverValue(v value.Value) (driver.Value, error) {
+	return value.Any(v)
+}
@@ -0,0 +1,93 @@
+package xsql
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestDriverValue(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		src  value.Value
+		dst  driver.Value
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.BoolValue(true),
+			dst:  true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.Int32Value(42),
+			dst:  int32(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.Uint64Value(42),
+			dst:  uint64(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.DoubleValue(42.5),
+			dst:  42.5,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TextValue("test"),
+			dst:  "test",
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.BytesValue([]byte("test")),
+			dst:  []byte("test"),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.JSONValue(`{"a":1}`),
+			dst:  []byte(`{"a":1}`),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.IntervalValueFromDuration(time.Second),
+			dst:  time.Second,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TimestampValueFromTime(time.Unix(1, 0)),
+			dst:  time.Unix(1, 0),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.TzDatetimeValue("2024-01-02T03:04:05,UTC"),
+			dst:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.OptionalValue(value.Int64Value(42)),
+			dst:  int64(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.NullValue(types.Int64),
+			dst:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  value.ListValue(value.Int64Value(1), value.Int64Value(2)),
+			dst:  value.ListValue(value.Int64Value(1), value.Int64Value(2)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dst, err := driverValue(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.dst, dst)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	baseTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/isolation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type queryTransaction struct {
+	baseTx.Identifier
+
+	conn *conn
+	ctx  context.Context //nolint:containedctx
+	tx   query.Transaction
+}
+
+var (
+	_ driver.Tx             = &queryTransaction{}
+	_ driver.ExecerContext  = &queryTransaction{}
+	_ driver.QueryerContext = &queryTransaction{}
+	_ baseTx.Identifier     = &queryTransaction{}
+)
+
+func (c *conn) beginQueryTx(ctx context.Context, txOptions driver.TxOptions) (currentTx, error) {
+	if c.currentTx != nil {
+		return nil, badconn.Map(
+			xerrors.WithStackTrace(
+				fmt.Errorf("broken conn state: conn=%q already have current tx=%q",
+					c.ID(), c.currentTx.ID(),
+				),
+			),
+		)
+	}
+	txSettings, err := isolation.ToQueryTxSettings(txOptions)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	nativeTx, err := c.querySession.Begin(ctx, query.TxSettings(txSettings))
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+	c.currentTx = &queryTransaction{
+		Identifier: nativeTx, // lazy transaction gets actual identifier on first query
+		conn:       c,
+		ctx:        ctx,
+		tx:         nativeTx,
+	}
+
+	return c.currentTx, nil
+}
+
+func (tx *queryTransaction) checkTxState() error {
+	if tx.conn.currentTx == tx {
+		return nil
+	}
+	if tx.conn.currentTx == nil {
+		return fmt.Errorf("broken conn state: tx=%q not related to conn=%q",
+			tx.ID(), tx.conn.ID(),
+		)
+	}
+
+	return fmt.Errorf("broken conn state: tx=%s not related to conn=%q (conn have current tx=%q)",
+		tx.conn.currentTx.ID(), tx.conn.ID(), tx.ID(),
+	)
+}
+
+func (tx *queryTransaction) Commit() (finalErr error) {
+	var (
+		ctx    = tx.ctx
+		onDone = trace.DatabaseSQLOnTxCommit(tx.conn.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*queryTransaction).Commit"),
+			tx,
+		)
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if err := tx.checkTxState(); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	defer func() {
+		tx.conn.currentTx = nil
+	}()
+	if err := tx.tx.CommitTx(tx.ctx); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return nil
+}
+
+func (tx *queryTransaction) Rollback() (finalErr error) {
+	var (
+		ctx    = tx.ctx
+		onDone = trace.DatabaseSQLOnTxRollback(tx.conn.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*queryTransaction).Rollback"),
+			tx,
+		)
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if err := tx.checkTxState(); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	defer func() {
+		tx.conn.currentTx = nil
+	}()
+	if tx.tx.ID() == baseTx.LazyTxID {
+		// lazy transaction was not started on server-side, nothing to rollback.
+		// Rollback of lazy transaction closes the session, but session is owned by conn
+		return nil
+	}
+	if err := tx.tx.Rollback(tx.ctx); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return nil
+}
+
+func (tx *queryTransaction) QueryContext(ctx context.Context, q string, args []driver.NamedValue) (
+	_ driver.Rows, finalErr error,
+) {
+	onDone := trace.DatabaseSQLOnTxQuery(tx.conn.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*queryTransaction).QueryContext"),
+		tx.ctx, tx, q,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	m := queryModeFromContext(ctx, tx.conn.defaultQueryMode)
+	if m != DataQueryMode {
+		return nil, badconn.Map(
+			xerrors.WithStackTrace(
+				xerrors.Retryable(
+					fmt.Errorf("wrong query mode: %s", m.String()),
+					xerrors.InvalidObject(),
+					xerrors.WithName("WRONG_QUERY_MODE"),
+				),
+			),
+		)
+	}
+	q, parameters, err := tx.conn.normalize(q, args...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	res, err := tx.tx.Query(ctx, q, query.WithParameters(&parameters))
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return newQueryRows(ctx, tx.conn, res), nil
+}
+
+func (tx *queryTransaction) ExecContext(ctx context.Context, q string, args []driver.NamedValue) (
+	_ driver.Result, finalErr error,
+) {
+	onDone := trace.DatabaseSQLOnTxExec(tx.conn.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*queryTransaction).ExecContext"),
+		tx.ctx, tx, q,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	m := queryModeFromContext(ctx, tx.conn.defaultQueryMode)
+	if m != DataQueryMode {
+		return nil, badconn.Map(
+			xerrors.WithStackTrace(
+				xerrors.Retryable(
+					fmt.Errorf("wrong query mode: %s", m.String()),
+					xerrors.InvalidObject(),
+					xerrors.WithName("WRONG_QUERY_MODE"),
+				),
+			),
+		)
+	}
+	q, parameters, err := tx.conn.normalize(q, args...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	err = tx.tx.Exec(ctx, q, query.WithParameters(&parameters))
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return resultNoRows{}, nil
+}
+
+func (tx *queryTransaction) PrepareContext(ctx context.Context, q string) (_ driver.Stmt, finalErr error) {
+	onDone := trace.DatabaseSQLOnTxPrepare(tx.conn.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*queryTransaction).PrepareContext"),
+		tx.ctx, tx, q,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if !tx.conn.isReady() {
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
+	}
+
+	return &stmt{
+		conn:      tx.conn,
+		processor: tx,
+		ctx:       ctx,
+		query:     q,
+		trace:     tx.conn.trace,
+	}, nil
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -492,6 +494,45 @@ func WithSessionPoolSizeLimit(sizeLimit int) Option {
 	}
 }
 
+// WithSessionPoolMinSize set minimum count of sessions which table.Client and query.Client
+// prefill in background after client initialization and refill when sessions closed.
+// Call db.Query().WaitReady(ctx) or db.Table().(table.ReadinessWaiter).WaitReady(ctx) on application
+// start for initialize client and wait warm sessions before reporting service as healthy
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionPoolMinSize(minSize int) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithPoolMinSize(minSize))
+		d.queryOptions = append(d.queryOptions, queryConfig.WithPoolMinSize(minSize))
+
+		return nil
+	}
+}
+
+// WithQueryPoolStatsCallback set callback which called with snapshot of query.Client session pool stats
+// periodically with interval
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryPoolStatsCallback(interval time.Duration, onStats func(stats query.PoolStats)) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.queryOptions = append(d.queryOptions, queryConfig.WithPoolStatsCallback(interval, onStats))
+
+		return nil
+	}
+}
+
+// WithTablePoolStatsCallback set callback which called with snapshot of table.Client session pool stats
+// periodically with interval
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTablePoolStatsCallback(interval time.Duration, onStats func(stats table.PoolStats)) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithPoolStatsCallback(interval, onStats))
+
+		return nil
+	}
+}
+
 // WithSessionPoolSessionUsageLimit set max count for use session
 func WithSessionPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	return func(ctx context.Context, d *Driver) error {
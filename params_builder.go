@@ -1,6 +1,9 @@
 package ydb
 
-import "github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
 
 // ParamsBuilder used for create query arguments instead of tons options.
 //
@@ -8,3 +11,22 @@ import "github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 func ParamsBuilder() params.Builder {
 	return params.Builder{}
 }
+
+// ParamsFromStruct makes query arguments from exported fields of tagged struct v. Parameter names are
+// taken from "sql" tag (as query.Row.ScanStruct does) or field name with "$" prefix. Pointers becomes
+// optional values, slices becomes lists (slice of structs becomes List<Struct<...>>), nested structs
+// becomes struct values. Tag name can be redefined with query.WithStructParamsTagName option.
+// v may be slice of structs, then it becomes one List<Struct<...>> parameter with name "$rows"
+// (name can be redefined with query.WithStructParamsListName option).
+//
+// Result can be passed to sugar.GenerateDeclareSection for making DECLARE section of query.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParamsFromStruct(v interface{}, opts ...params.FromStructOption) (*params.Parameters, error) {
+	parameters, err := params.FromStruct(v, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return parameters, nil
+}
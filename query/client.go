@@ -5,12 +5,16 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 type (
+	// PoolStats is a snapshot of session pool stats
+	PoolStats = stats.Stats
+
 	// Executor is an interface for execute queries
 	Executor interface {
 		// Exec execute query without result
@@ -102,6 +106,37 @@ type (
 			ctx context.Context, opID string, opts ...options.FetchScriptOption,
 		) (*options.FetchScriptResult, error)
 	}
+
+	// PoolStatsProvider is an optional interface of Client for observing of session pool.
+	// Query client of ydb.Driver implements PoolStatsProvider
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PoolStatsProvider interface {
+		// Stats returns snapshot of session pool stats
+		Stats() PoolStats
+	}
+
+	// ReadinessWaiter is an optional interface of Client for waiting of warm session pool.
+	// Query client of ydb.Driver implements ReadinessWaiter
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ReadinessWaiter interface {
+		// WaitReady waits until session pool prefills minimum count of sessions
+		// (see config.WithPoolMinSize and ydb.WithSessionPoolMinSize) or context is done.
+		// WaitReady returns immediately if minimum size of session pool is not defined
+		WaitReady(ctx context.Context) error
+	}
+
+	// Explainer is an optional interface of Client for explaining queries.
+	// Query client of ydb.Driver implements Explainer
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Explainer interface {
+		// Explain explains query and returns query AST and parsed query plan.
+		// Explanation.Plan.String() renders plan as text tree, Explanation.Plan.FullScans()
+		// returns full scan operators of plan (with estimated rows) for check queries in CI
+		Explain(ctx context.Context, query string, opts ...options.Execute) (*Explanation, error)
+	}
 )
 
 func WithFetchToken(fetchToken string) options.FetchScriptOption {
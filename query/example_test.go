@@ -533,3 +533,49 @@ func Example_executeScript() {
 		}
 	}
 }
+
+func Example_startScript() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close(ctx) // cleanup resources
+	script, err := db.Query().StartScript(ctx,
+		`SELECT CAST($id AS Uint64) AS id, CAST($myStr AS Text) AS myStr`,
+		time.Hour,
+		query.WithParameters(
+			ydb.ParamsBuilder().
+				Param("$id").Uint64(123).
+				Param("$myStr").Text("123").
+				Build(),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = script.Forget(ctx)
+	}()
+
+	// Wait polls script execution status with backoff
+	if _, err = script.Wait(ctx); err != nil {
+		panic(err)
+	}
+
+	// Rows follows fetch tokens of all result sets of script
+	for row, err := range script.Rows(ctx, query.WithRowsLimit(1000)) {
+		if err != nil {
+			panic(err)
+		}
+		var (
+			id    int64
+			myStr string
+		)
+		err = row.Scan(&id, &myStr)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("id=%v, myStr='%s'\n", id, myStr)
+	}
+}
@@ -0,0 +1,28 @@
+package query
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/explain"
+)
+
+type (
+	// Explanation is a result of Client.Explain
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Explanation = explain.Explanation
+	// Plan is a parsed query plan
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Plan            = explain.Plan
+	PlanNode        = explain.Node
+	PlanOperator    = explain.Operator
+	PlanEstimate    = explain.Estimate
+	PlanTable       = explain.Table
+	PlanTableAccess = explain.TableAccess
+)
+
+// ParsePlan parses query plan in JSON format (such as Stats.QueryPlan())
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParsePlan(plan string) (*Plan, error) {
+	return explain.Parse(plan)
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+)
+
+type (
+	// ScriptStarter is an optional interface of Client for starting of managed script executions.
+	// Query client of ydb.Driver implements ScriptStarter
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptStarter interface {
+		// StartScript starts long executing script and returns handle for waiting script completion,
+		// cancel or forget script execution and iterate over script results
+		StartScript(
+			ctx context.Context, query string, ttl time.Duration, ops ...options.Execute,
+		) (ScriptExecution, error)
+	}
+
+	// ScriptExecution is a handle of long-running script execution started by ScriptStarter.StartScript
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptExecution interface {
+		// ID returns operation ID of script execution
+		ID() string
+
+		// Wait polls script execution status with backoff until script is completed
+		// or context is done. Wait returns error if script execution was failed or cancelled
+		Wait(ctx context.Context) (*options.MetadataExecuteQuery, error)
+
+		// Cancel starts cancellation of script execution
+		Cancel(ctx context.Context) error
+
+		// Forget forgets completed script execution and releases its results on server side
+		Forget(ctx context.Context) error
+
+		// ResultSets waits script completion and returns iterator over script result sets.
+		// Rows of result sets are fetched page by page following fetch tokens.
+		// Only WithRowsLimit option takes effect, result set index and fetch token are managed by iterator
+		ResultSets(ctx context.Context, opts ...options.FetchScriptOption) xiter.Seq2[ResultSet, error]
+
+		// Rows waits script completion and returns iterator over rows of all script result sets
+		Rows(ctx context.Context, opts ...options.FetchScriptOption) xiter.Seq2[Row, error]
+	}
+)
@@ -23,7 +23,8 @@ type (
 
 		Begin(ctx context.Context, txSettings TransactionSettings) (Transaction, error)
 	}
-	Stats = stats.QueryStats
+	Stats              = stats.QueryStats
+	StructParamsOption = params.FromStructOption
 )
 
 const (
@@ -49,6 +50,38 @@ func WithParameters(parameters *params.Parameters) options.Execute {
 	return options.WithParameters(parameters)
 }
 
+// WithStructParams makes query parameters from exported fields of tagged struct v (see params.FromStruct
+// for conversion rules). Slice fields of structs becomes List<Struct<...>> parameters which can be used
+// with AS_TABLE. v may be slice of structs too, then it becomes one List<Struct<...>> parameter "$rows"
+// (name can be changed with WithStructParamsListName). Conversion error returns on query execution.
+//
+// Matching DECLARE section can be generated with:
+//
+//	parameters, err := ydb.ParamsFromStruct(v)
+//	if err != nil {
+//		return err
+//	}
+//	declares, err := sugar.GenerateDeclareSection(parameters)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStructParams(v interface{}, opts ...StructParamsOption) options.Execute {
+	return options.WithStructParameters(v, opts...)
+}
+
+// WithStructParamsTagName defines struct tag name for lookup parameter names. Default tag name is "sql"
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStructParamsTagName(name string) StructParamsOption {
+	return params.WithTagName(name)
+}
+
+// WithStructParamsListName defines parameter name for slice of structs. Default name is "$rows"
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStructParamsListName(name string) StructParamsOption {
+	return params.WithListName(name)
+}
+
 func WithTxControl(txControl *tx.Control) options.Execute {
 	return options.WithTxControl(txControl)
 }
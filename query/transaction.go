@@ -12,6 +12,21 @@ type (
 		tx.Identifier
 		Executor
 	}
+	// TxHooks is an optional interface of TxActor for callbacks on completion of transaction.
+	// Transactions of query client of ydb.Driver implement TxHooks
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	TxHooks interface {
+		// OnCommit registers callback which will be called after successful commit of transaction.
+		// Callbacks are bound to the transaction: if DoTx retries operation, callbacks registered
+		// within failed attempt are not called on commit of next attempt
+		OnCommit(f func())
+
+		// OnRollback registers callback which will be called if transaction was rolled back or
+		// commit of transaction failed. Callback receives the reason: commit error, error of
+		// failed DoTx attempt or rolling back error on explicit rollback
+		OnRollback(f func(err error))
+	}
 	Transaction interface {
 		TxActor
 
@@ -156,6 +156,18 @@ func WithDisableServerBalancer() ConnectorOption {
 	return xsql.WithDisableServerBalancer()
 }
 
+// WithQueryService enables execution of database/sql queries over query service instead of table service.
+// Connections over query service read results as streams and use lazy transactions (if enabled in query
+// client config).
+// Isolation levels of database/sql transactions and query bindings works as for table service.
+// Transaction control for queries outside of transactions may be defined with WithTxControl,
+// by default query service chooses transaction mode on server-side
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryService(enabled bool) ConnectorOption {
+	return xsql.WithQueryService(enabled)
+}
+
 type SQLConnector interface {
 	driver.Connector
 
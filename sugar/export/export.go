@@ -0,0 +1,273 @@
+// Package export writes results of queries and table reads to CSV or JSON Lines streams
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+)
+
+// Format is a format of exported rows
+type Format int
+
+const (
+	// FormatCSV is a RFC 4180 CSV format. Primitive values rendered in YDB text format
+	// (for example, 2024-01-02T03:04:05.000000Z for Timestamp), containers rendered as JSON
+	FormatCSV = Format(iota)
+
+	// FormatJSONLines is a JSON Lines format: each row is a JSON object with fields in order of columns.
+	// Numbers and booleans rendered as JSON numbers and booleans, Json and JsonDocument values embedded as is,
+	// lists, sets and tuples rendered as arrays, structs as objects, dicts as arrays of [key, value] pairs,
+	// variants as [name or index, value] pairs and other values as strings
+	FormatJSONLines
+)
+
+type (
+	config struct {
+		header bool
+		null   string
+		comma  rune
+		base64 bool
+	}
+	Option func(c *config)
+)
+
+// WithHeader enables or disables header with names of columns before rows of each result set in CSV.
+// Header is enabled by default
+func WithHeader(header bool) Option {
+	return func(c *config) {
+		c.header = header
+	}
+}
+
+// WithNull sets text of NULL values in CSV. Default is empty string
+func WithNull(null string) Option {
+	return func(c *config) {
+		c.null = null
+	}
+}
+
+// WithComma sets fields delimiter of CSV. Default is ','
+func WithComma(comma rune) Option {
+	return func(c *config) {
+		c.comma = comma
+	}
+}
+
+// WithBase64Bytes enables base64 encoding of String (Bytes) and Yson values. By default values
+// written as is (in JSON invalid UTF-8 sequences replaced with U+FFFD)
+func WithBase64Bytes() Option {
+	return func(c *config) {
+		c.base64 = true
+	}
+}
+
+type writer struct {
+	config
+	renderer
+
+	format Format
+	buffer *bufio.Writer
+	csv    *csv.Writer
+	line   []byte
+	record []string
+}
+
+func newWriter(w io.Writer, format Format, opts []Option) *writer {
+	ww := &writer{
+		config: config{
+			header: true,
+			comma:  ',',
+		},
+		format: format,
+		buffer: bufio.NewWriter(w),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&ww.config)
+		}
+	}
+	ww.renderer.base64 = ww.config.base64
+	ww.csv = csv.NewWriter(ww.buffer)
+	ww.csv.Comma = ww.comma
+
+	return ww
+}
+
+func (w *writer) writeHeader(columns []string) error {
+	if w.format != FormatCSV || !w.header {
+		return nil
+	}
+
+	if err := w.csv.Write(columns); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (w *writer) writeRow(columns []string, values []value.Value) (err error) {
+	if w.format == FormatJSONLines {
+		w.line = append(w.line[:0], '{')
+		for i := range values {
+			if i > 0 {
+				w.line = append(w.line, ',')
+			}
+			w.line = appendString(w.line, columns[i])
+			w.line = append(w.line, ':')
+			if w.line, err = w.appendJSON(w.line, values[i]); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		w.line = append(w.line, '}', '\n')
+		if _, err = w.buffer.Write(w.line); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}
+
+	w.record = w.record[:0]
+	for i := range values {
+		s, valid, err := w.text(values[i])
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if !valid {
+			s = w.null
+		}
+		w.record = append(w.record, s)
+	}
+	if err = w.csv.Write(w.record); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (w *writer) flush() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err := w.buffer.Flush(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// TableResult writes all result sets of table service result (for example, result of StreamReadTable or
+// StreamExecuteScanQuery) to w in given format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func TableResult(ctx context.Context, w io.Writer, res result.BaseResult, format Format, opts ...Option) error {
+	ww := newWriter(w, format, opts)
+
+	for res.NextResultSet(ctx) {
+		var columns []string
+		res.CurrentResultSet().Columns(func(c options.Column) {
+			columns = append(columns, c.Name)
+		})
+		if err := ww.writeHeader(columns); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		var (
+			values = make([]value.Value, len(columns))
+			dst    = make([]indexed.RequiredOrOptional, len(columns))
+		)
+		for i := range values {
+			dst[i] = &values[i]
+		}
+		for res.NextRow() {
+			if err := res.Scan(dst...); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if err := ww.writeRow(columns, values); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	if err := res.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return ww.flush()
+}
+
+// QueryResult writes all result sets of query service result to w in given format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func QueryResult(ctx context.Context, w io.Writer, res query.Result, format Format, opts ...Option) error {
+	ww := newWriter(w, format, opts)
+
+	for {
+		rs, err := res.NextResultSet(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+		if err = writeResultSet(ctx, ww, rs); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return ww.flush()
+}
+
+// QueryResultSet writes rows of query service result set to w in given format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func QueryResultSet(ctx context.Context, w io.Writer, rs query.ResultSet, format Format, opts ...Option) error {
+	ww := newWriter(w, format, opts)
+
+	if err := writeResultSet(ctx, ww, rs); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return ww.flush()
+}
+
+func writeResultSet(ctx context.Context, w *writer, rs query.ResultSet) error {
+	columns := rs.Columns()
+	if err := w.writeHeader(columns); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var (
+		values = make([]value.Value, len(columns))
+		dst    = make([]interface{}, len(columns))
+	)
+	for i := range values {
+		dst[i] = &values[i]
+	}
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+		if err = row.Scan(dst...); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if err = w.writeRow(columns, values); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/sugar/export"
+)
+
+func testRows(a *allocator.Allocator) (columns []*Ydb.Column, rows []*Ydb.Value) {
+	columns = []*Ydb.Column{
+		{Name: "id", Type: types.TypeToYDB(types.Uint64, a)},
+		{Name: "title", Type: types.TypeToYDB(types.NewOptional(types.Text), a)},
+		{Name: "tags", Type: types.TypeToYDB(types.NewList(types.Text), a)},
+	}
+	for _, row := range []value.Value{
+		value.TupleValue(
+			value.Uint64Value(1),
+			value.OptionalValue(value.TextValue("first, \"quoted\"")),
+			value.ListValue(value.TextValue("a"), value.TextValue("b")),
+		),
+		value.TupleValue(
+			value.Uint64Value(2),
+			value.NullValue(types.Text),
+			value.ListValue(value.TextValue("c")),
+		),
+	} {
+		rows = append(rows, value.ToYDB(row, a).GetValue())
+	}
+
+	return columns, rows
+}
+
+func TestTableResult(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	columns, rows := testRows(a)
+	res := func() *Ydb.ResultSet {
+		return &Ydb.ResultSet{Columns: columns, Rows: rows}
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buffer bytes.Buffer
+		err := export.TableResult(context.Background(), &buffer,
+			scanner.NewUnary([]*Ydb.ResultSet{res(), res()}, nil), export.FormatCSV,
+			export.WithNull("\\N"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, ""+
+			"id,title,tags\n"+
+			"1,\"first, \"\"quoted\"\"\",\"[\"\"a\"\",\"\"b\"\"]\"\n"+
+			"2,\\N,\"[\"\"c\"\"]\"\n"+
+			"id,title,tags\n"+
+			"1,\"first, \"\"quoted\"\"\",\"[\"\"a\"\",\"\"b\"\"]\"\n"+
+			"2,\\N,\"[\"\"c\"\"]\"\n",
+			buffer.String(),
+		)
+	})
+	t.Run("CSVWithoutHeader", func(t *testing.T) {
+		var buffer bytes.Buffer
+		err := export.TableResult(context.Background(), &buffer,
+			scanner.NewUnary([]*Ydb.ResultSet{res()}, nil), export.FormatCSV,
+			export.WithHeader(false), export.WithComma(';'),
+		)
+		require.NoError(t, err)
+		require.Equal(t, ""+
+			"1;\"first, \"\"quoted\"\"\";\"[\"\"a\"\",\"\"b\"\"]\"\n"+
+			"2;;\"[\"\"c\"\"]\"\n",
+			buffer.String(),
+		)
+	})
+	t.Run("JSONLines", func(t *testing.T) {
+		var buffer bytes.Buffer
+		err := export.TableResult(context.Background(), &buffer,
+			scanner.NewUnary([]*Ydb.ResultSet{res()}, nil), export.FormatJSONLines,
+		)
+		require.NoError(t, err)
+		require.Equal(t, ""+
+			`{"id":1,"title":"first, \"quoted\"","tags":["a","b"]}`+"\n"+
+			`{"id":2,"title":null,"tags":["c"]}`+"\n",
+			buffer.String(),
+		)
+	})
+}
+
+func TestQueryResultSet(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	columns, rows := testRows(a)
+	queryRows := make([]query.Row, 0, len(rows))
+	for i := range rows {
+		queryRows = append(queryRows, internalQuery.NewRow(columns, rows[i]))
+	}
+	rs := internalQuery.MaterializedResultSet(0,
+		[]string{"id", "title", "tags"},
+		[]types.Type{types.Uint64, types.NewOptional(types.Text), types.NewList(types.Text)},
+		queryRows,
+	)
+
+	var buffer bytes.Buffer
+	err := export.QueryResultSet(context.Background(), &buffer, rs, export.FormatJSONLines)
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		`{"id":1,"title":"first, \"quoted\"","tags":["a","b"]}`+"\n"+
+		`{"id":2,"title":null,"tags":["c"]}`+"\n",
+		buffer.String(),
+	)
+}
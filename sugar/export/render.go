@@ -0,0 +1,324 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	dateLayout      = "2006-01-02"
+	datetimeLayout  = "2006-01-02T15:04:05Z"
+	timestampLayout = "2006-01-02T15:04:05.000000Z"
+)
+
+var errUnsupportedValue = errors.New("unsupported value")
+
+type (
+	listItems interface {
+		ListItems() []value.Value
+	}
+	setItems interface {
+		SetItems() []value.Value
+	}
+	tupleItems interface {
+		TupleItems() []value.Value
+	}
+	structFields interface {
+		Fields() []value.StructValueField
+	}
+	dictFields interface {
+		Fields() []value.DictValueField
+	}
+	variant interface {
+		Variant() (name string, index uint32)
+		Value() value.Value
+	}
+)
+
+// renderer renders values of result sets as text of CSV fields or JSON
+type renderer struct {
+	base64 bool
+}
+
+// text returns text of value v for CSV field. Containers rendered as JSON. text returns false for NULL values
+func (r *renderer) text(v value.Value) (string, bool, error) {
+	v = unwrap(v)
+	if v == nil {
+		return "", false, nil
+	}
+
+	if _, primitive := v.Type().(types.Primitive); !primitive && !isDecimal(v) && !isPg(v) {
+		b, err := r.appendJSON(nil, v)
+		if err != nil {
+			return "", false, xerrors.WithStackTrace(err)
+		}
+
+		return string(b), true, nil
+	}
+
+	s, err := r.primitive(v)
+	if err != nil {
+		return "", false, xerrors.WithStackTrace(err)
+	}
+
+	return s, true, nil
+}
+
+// primitive returns text representation of primitive value v in YDB text format
+//
+//nolint:funlen
+func (r *renderer) primitive(v value.Value) (s string, err error) {
+	if d, ok := v.(value.DecimalValuer); ok {
+		return decimal.Format(decimal.FromInt128(d.Value(), d.Precision(), d.Scale()), d.Precision(), d.Scale()), nil
+	}
+	if t, ok := v.Type().(types.PgType); ok {
+		return pgText(v, t), nil
+	}
+
+	switch v.Type() {
+	case types.Float, types.Double:
+		var f float64
+		if err = value.CastTo(v, &f); err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		bitSize := 64
+		if v.Type() == types.Float {
+			bitSize = 32
+		}
+
+		return formatFloat(f, bitSize), nil
+	case types.Date, types.Datetime, types.Timestamp:
+		var t time.Time
+		if err = value.CastTo(v, &t); err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		switch v.Type() {
+		case types.Date:
+			return t.UTC().Format(dateLayout), nil
+		case types.Datetime:
+			return t.UTC().Format(datetimeLayout), nil
+		default:
+			return t.UTC().Format(timestampLayout), nil
+		}
+	case types.Interval:
+		return literal(v.Yql()), nil
+	case types.UUID:
+		var id [16]byte
+		if err = value.CastTo(v, &id); err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+
+		return uuid.UUID(id).String(), nil
+	case types.Bytes, types.YSON:
+		var b []byte
+		if err = value.CastTo(v, &b); err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		if r.base64 {
+			return base64.StdEncoding.EncodeToString(b), nil
+		}
+
+		return string(b), nil
+	default:
+		if err = value.CastTo(v, &s); err != nil {
+			return "", xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedValue, v.Type().Yql()))
+		}
+
+		return s, nil
+	}
+}
+
+// appendJSON appends JSON representation of value v to b
+//
+//nolint:funlen,gocyclo
+func (r *renderer) appendJSON(b []byte, v value.Value) (_ []byte, err error) {
+	v = unwrap(v)
+	if v == nil {
+		return append(b, "null"...), nil
+	}
+
+	switch vv := v.(type) {
+	case structFields:
+		b = append(b, '{')
+		for i, f := range vv.Fields() {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendString(b, f.Name)
+			b = append(b, ':')
+			if b, err = r.appendJSON(b, f.V); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+
+		return append(b, '}'), nil
+	case dictFields:
+		b = append(b, '[')
+		for i, f := range vv.Fields() {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, '[')
+			if b, err = r.appendJSON(b, f.K); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			b = append(b, ',')
+			if b, err = r.appendJSON(b, f.V); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			b = append(b, ']')
+		}
+
+		return append(b, ']'), nil
+	case listItems:
+		return r.appendJSONArray(b, vv.ListItems())
+	case setItems:
+		return r.appendJSONArray(b, vv.SetItems())
+	case tupleItems:
+		return r.appendJSONArray(b, vv.TupleItems())
+	case variant:
+		name, index := vv.Variant()
+		b = append(b, '[')
+		if name != "" {
+			b = appendString(b, name)
+		} else {
+			b = strconv.AppendUint(b, uint64(index), 10)
+		}
+		b = append(b, ',')
+		if b, err = r.appendJSON(b, vv.Value()); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return append(b, ']'), nil
+	}
+
+	switch v.Type() {
+	case types.Bool, types.Int8, types.Int16, types.Int32, types.Int64,
+		types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		s, err := r.primitive(v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return append(b, s...), nil
+	case types.Float, types.Double:
+		s, err := r.primitive(v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if s == "nan" || s == "inf" || s == "-inf" {
+			// nan and infinities are not representable as JSON numbers
+			return appendString(b, s), nil
+		}
+
+		return append(b, s...), nil
+	case types.JSON, types.JSONDocument:
+		s, err := r.primitive(v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if !json.Valid([]byte(s)) {
+			return appendString(b, s), nil
+		}
+
+		return append(b, s...), nil
+	default:
+		s, err := r.primitive(v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return appendString(b, s), nil
+	}
+}
+
+func (r *renderer) appendJSONArray(b []byte, items []value.Value) (_ []byte, err error) {
+	b = append(b, '[')
+	for i := range items {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if b, err = r.appendJSON(b, items[i]); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return append(b, ']'), nil
+}
+
+// unwrap returns value inside of optionals or nil for NULL
+func unwrap(v value.Value) value.Value {
+	for v != nil {
+		switch v.Type().(type) {
+		case types.Void, types.Null:
+			return nil
+		case types.Optional:
+			v = value.Unwrap(v)
+		default:
+			return v
+		}
+	}
+
+	return nil
+}
+
+func isDecimal(v value.Value) bool {
+	_, ok := v.(value.DecimalValuer)
+
+	return ok
+}
+
+func isPg(v value.Value) bool {
+	_, ok := v.Type().(types.PgType)
+
+	return ok
+}
+
+// pgText returns text of postgres value from its YQL literal PgConst("text", PgType(oid))
+func pgText(v value.Value, t types.PgType) string {
+	s := strings.TrimPrefix(v.Yql(), `PgConst("`)
+
+	return strings.TrimSuffix(s, fmt.Sprintf(`", PgType(%v))`, t.OID))
+}
+
+// literal returns quoted literal from YQL literal of value such as Interval("PT1S")
+func literal(yql string) string {
+	start, end := strings.IndexByte(yql, '"'), strings.LastIndexByte(yql, '"')
+	if start < 0 || end <= start {
+		return yql
+	}
+
+	return yql[start+1 : end]
+}
+
+func formatFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, bitSize)
+	}
+}
+
+func appendString(b []byte, s string) []byte {
+	quoted, _ := json.Marshal(s) //nolint:errchkjson
+
+	return append(b, quoted...)
+}
@@ -0,0 +1,144 @@
+package export
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestRenderer(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	for _, tt := range []struct {
+		name string
+		v    value.Value
+		text string
+		null bool
+		json string
+	}{
+		{name: "Bool", v: value.BoolValue(true), text: "true", json: `true`},
+		{name: "Int8", v: value.Int8Value(-8), text: "-8", json: `-8`},
+		{name: "Uint64", v: value.Uint64Value(math.MaxUint64), text: "18446744073709551615", json: `18446744073709551615`},
+		{name: "Float", v: value.FloatValue(0.1), text: "0.1", json: `0.1`},
+		{name: "Double", v: value.DoubleValue(1e300), text: "1e+300", json: `1e+300`},
+		{name: "NaN", v: value.DoubleValue(math.NaN()), text: "nan", json: `"nan"`},
+		{name: "Inf", v: value.DoubleValue(math.Inf(-1)), text: "-inf", json: `"-inf"`},
+		{name: "Date", v: value.DateValueFromTime(ts), text: "2024-01-02", json: `"2024-01-02"`},
+		{name: "Datetime", v: value.DatetimeValueFromTime(ts), text: "2024-01-02T03:04:05Z", json: `"2024-01-02T03:04:05Z"`},
+		{
+			name: "Timestamp",
+			v:    value.TimestampValueFromTime(ts),
+			text: "2024-01-02T03:04:05.123456Z",
+			json: `"2024-01-02T03:04:05.123456Z"`,
+		},
+		{
+			name: "Interval",
+			v:    value.IntervalValueFromDuration(26*time.Hour + 1500*time.Millisecond),
+			text: "P1DT2H1.500000S",
+			json: `"P1DT2H1.500000S"`,
+		},
+		{
+			name: "TzDatetime",
+			v:    value.TzDatetimeValue("2024-01-02T03:04:05,Europe/Moscow"),
+			text: "2024-01-02T03:04:05,Europe/Moscow",
+			json: `"2024-01-02T03:04:05,Europe/Moscow"`,
+		},
+		{
+			name: "Decimal",
+			v:    value.DecimalValueFromBigInt(big.NewInt(-12345), 22, 9),
+			text: "-0.000012345",
+			json: `"-0.000012345"`,
+		},
+		{
+			name: "UUID",
+			v:    value.UUIDValue([16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 1, 2, 3, 4, 5, 6, 7, 8}),
+			text: "12345678-9abc-def0-0102-030405060708",
+			json: `"12345678-9abc-def0-0102-030405060708"`,
+		},
+		{name: "Text", v: value.TextValue(`a "b", c`), text: `a "b", c`, json: `"a \"b\", c"`},
+		{name: "Bytes", v: value.BytesValue([]byte("abc")), text: "abc", json: `"abc"`},
+		{name: "Yson", v: value.YSONValue([]byte("{a=1}")), text: "{a=1}", json: `"{a=1}"`},
+		{name: "JSON", v: value.JSONValue(`{"a": [1, 2]}`), text: `{"a": [1, 2]}`, json: `{"a": [1, 2]}`},
+		{name: "JSONDocument", v: value.JSONDocumentValue(`{"a":1}`), text: `{"a":1}`, json: `{"a":1}`},
+		{name: "DyNumber", v: value.DyNumberValue(".123e3"), text: ".123e3", json: `".123e3"`},
+		{name: "Null", v: value.NullValue(types.Text), null: true, json: `null`},
+		{name: "Void", v: value.VoidValue(), null: true, json: `null`},
+		{name: "Optional", v: value.OptionalValue(value.OptionalValue(value.Int32Value(1))), text: "1", json: `1`},
+		{
+			name: "OptionalNull",
+			v:    value.OptionalValue(value.NullValue(types.Int32)),
+			null: true,
+			json: `null`,
+		},
+		{
+			name: "List",
+			v:    value.ListValue(value.OptionalValue(value.Int32Value(1)), value.NullValue(types.Int32)),
+			text: "[1,null]",
+			json: `[1,null]`,
+		},
+		{
+			name: "Tuple",
+			v:    value.TupleValue(value.TextValue("a"), value.BoolValue(false)),
+			text: `["a",false]`,
+			json: `["a",false]`,
+		},
+		{
+			name: "Struct",
+			v: value.StructValue(
+				value.StructValueField{Name: "b", V: value.ListValue(value.DateValueFromTime(ts))},
+				value.StructValueField{Name: "a", V: value.Uint8Value(1)},
+			),
+			text: `{"a":1,"b":["2024-01-02"]}`,
+			json: `{"a":1,"b":["2024-01-02"]}`,
+		},
+		{
+			name: "Dict",
+			v: value.DictValue(
+				value.DictValueField{K: value.TextValue("x"), V: value.Int64Value(1)},
+				value.DictValueField{K: value.TextValue("y"), V: value.Int64Value(2)},
+			),
+			text: `[["x",1],["y",2]]`,
+			json: `[["x",1],["y",2]]`,
+		},
+		{
+			name: "VariantStruct",
+			v: value.VariantValueStruct(value.Int32Value(7), "num", types.NewVariantStruct(
+				types.StructField{Name: "num", T: types.Int32},
+				types.StructField{Name: "str", T: types.Text},
+			)),
+			text: `["num",7]`,
+			json: `["num",7]`,
+		},
+		{
+			name: "VariantTuple",
+			v:    value.VariantValueTuple(value.TextValue("s"), 1, types.NewVariantTuple(types.Int32, types.Text)),
+			text: `[1,"s"]`,
+			json: `[1,"s"]`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &renderer{}
+			text, valid, err := r.text(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, !tt.null, valid)
+			require.Equal(t, tt.text, text)
+			b, err := r.appendJSON(nil, tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.json, string(b))
+		})
+	}
+	t.Run("Base64", func(t *testing.T) {
+		r := &renderer{base64: true}
+		text, _, err := r.text(value.BytesValue([]byte{0xff, 0x00}))
+		require.NoError(t, err)
+		require.Equal(t, "/wA=", text)
+		b, err := r.appendJSON(nil, value.YSONValue([]byte("{a=1}")))
+		require.NoError(t, err)
+		require.Equal(t, `"e2E9MX0="`, string(b))
+	})
+}
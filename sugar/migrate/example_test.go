@@ -0,0 +1,41 @@
+package migrate_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/sugar/migrate"
+)
+
+func Example() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close(ctx) // cleanup resources
+
+	// migrations directory contains files such as 001_init.up.sql, 001_init.down.sql, 002_add_index.up.sql
+	migrations, err := migrate.Load(os.DirFS("."), "migrations")
+	if err != nil {
+		panic(err)
+	}
+
+	m, err := migrate.New(db.Query(), migrations,
+		migrate.WithLock(db.Coordination(), "/local/migrations", "my-service"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	applied, err := m.Up(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range applied {
+		fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+	}
+}
@@ -0,0 +1,109 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+// AppliedMigration is a record of history table about applied migration
+type AppliedMigration struct {
+	Version   uint64
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+func createHistoryTable(ctx context.Context, db query.Executor, table string) error {
+	err := db.Exec(ctx, fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			version Uint64 NOT NULL,
+			name Text NOT NULL,
+			checksum Text NOT NULL,
+			applied_at Timestamp NOT NULL,
+			PRIMARY KEY (version)
+		)`, "`"+table+"`",
+	))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func readHistory(ctx context.Context, db query.Executor, table string) (applied []AppliedMigration, _ error) {
+	rs, err := db.QueryResultSet(ctx, fmt.Sprintf(`
+		SELECT version, name, checksum, applied_at FROM %s ORDER BY version`, "`"+table+"`",
+	))
+	if err != nil {
+		// history table not created yet (for example, in dry run mode) means no applied migrations
+		if xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR) {
+			return nil, nil
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = rs.Close(ctx)
+	}()
+
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return applied, nil
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		var m AppliedMigration
+		if err = row.Scan(&m.Version, &m.Name, &m.Checksum, &m.AppliedAt); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		applied = append(applied, m)
+	}
+}
+
+func insertHistory(ctx context.Context, db query.Executor, table string, m *Migration) error {
+	err := db.Exec(ctx, fmt.Sprintf(`
+		DECLARE $version AS Uint64;
+		DECLARE $name AS Text;
+		DECLARE $checksum AS Text;
+		UPSERT INTO %s (version, name, checksum, applied_at)
+		VALUES ($version, $name, $checksum, CurrentUtcTimestamp())`, "`"+table+"`",
+	), query.WithParameters(params.Builder{}.
+		Param("$version").Uint64(m.Version).
+		Param("$name").Text(m.Name).
+		Param("$checksum").Text(m.Checksum()).
+		Build(),
+	))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func deleteHistory(ctx context.Context, db query.Executor, table string, version uint64) error {
+	err := db.Exec(ctx, fmt.Sprintf(`
+		DECLARE $version AS Uint64;
+		DELETE FROM %s WHERE version = $version`, "`"+table+"`",
+	), query.WithParameters(params.Builder{}.
+		Param("$version").Uint64(version).
+		Build(),
+	))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
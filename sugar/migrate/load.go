@@ -0,0 +1,132 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	upSuffix   = ".up.sql"
+	downSuffix = ".down.sql"
+)
+
+// Migration is a versioned schema change with statements for apply (Up) and revert (Down)
+type Migration struct {
+	// Version is a unique version of migration. Migrations applies in ascending order of versions
+	Version uint64
+
+	// Name is a human-readable name of migration
+	Name string
+
+	// Up contains YQL statements which applies migration
+	Up string
+
+	// Down contains YQL statements which reverts migration. Empty Down means migration cannot be reverted
+	Down string
+}
+
+// Checksum returns checksum of Up statements. Checksum stored in history table on apply of migration
+// and checked on next runs for detect changes of applied migrations
+func (m *Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Load reads migrations from directory dir of file system fsys. Migration files must be named as
+// "<version>_<name>.up.sql" and "<version>_<name>.down.sql" (down file is optional).
+// Other files of directory are ignored. Load can be used with embed.FS:
+//
+//	//go:embed migrations/*.sql
+//	var migrations embed.FS
+//	...
+//	m, err := migrate.Load(migrations, "migrations")
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	migrations := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		fileName := entry.Name()
+
+		var suffix string
+		switch {
+		case strings.HasSuffix(fileName, upSuffix):
+			suffix = upSuffix
+		case strings.HasSuffix(fileName, downSuffix):
+			suffix = downSuffix
+		default:
+			continue
+		}
+
+		version, name, err := parseFileName(strings.TrimSuffix(fileName, suffix))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, fileName))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		m, has := migrations[version]
+		if !has {
+			m = &Migration{
+				Version: version,
+				Name:    name,
+			}
+			migrations[version] = m
+		} else if m.Name != name {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d (%q and %q)",
+				errDuplicateVersion, version, m.Name, name,
+			))
+		}
+
+		if suffix == upSuffix {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(migrations))
+	for _, m := range migrations {
+		if m.Up == "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d_%s%s", errNoUpFile, m.Version, m.Name, upSuffix))
+		}
+		result = append(result, *m)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+
+	return result, nil
+}
+
+func parseFileName(fileName string) (version uint64, name string, _ error) {
+	v, name, _ := strings.Cut(fileName, "_")
+
+	version, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, "", xerrors.WithStackTrace(fmt.Errorf("%w: %q", errInvalidFileName, fileName))
+	}
+
+	return version, name, nil
+}
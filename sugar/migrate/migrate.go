@@ -0,0 +1,355 @@
+// Package migrate applies versioned YQL migrations with history of applied migrations
+// in table and optional distributed lock based on coordination service semaphore
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	coordinationOptions "github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+const defaultHistoryTable = "schema_migrations"
+
+var (
+	// ErrChecksumMismatch returned if applied migration was changed after apply
+	ErrChecksumMismatch = errors.New("checksum of applied migration mismatch")
+
+	// ErrUnknownMigration returned if history table contains version which is not in list of migrations
+	ErrUnknownMigration = errors.New("applied migration not found")
+
+	// ErrIrreversibleMigration returned on revert of migration without down statements
+	ErrIrreversibleMigration = errors.New("migration has no down statements")
+
+	errDuplicateVersion = errors.New("duplicate migration version")
+	errInvalidFileName  = errors.New("invalid migration file name")
+	errNoUpFile         = errors.New("migration has no up file")
+)
+
+type (
+	// Migrator applies and reverts migrations
+	Migrator struct {
+		db           query.Executor
+		migrations   []Migration
+		historyTable string
+		lock         *lockConfig
+		dryRun       bool
+	}
+	lockConfig struct {
+		client    coordination.Client
+		nodePath  string
+		semaphore string
+	}
+	Option func(m *Migrator)
+)
+
+// WithHistoryTable sets path of table with history of applied migrations. Default is "schema_migrations"
+func WithHistoryTable(path string) Option {
+	return func(m *Migrator) {
+		m.historyTable = path
+	}
+}
+
+// WithLock makes migrator to acquire exclusive semaphore of coordination node before apply or revert of
+// migrations, so only one instance of application migrates at the same time. Coordination node created
+// if not exists. Migrations executes with context which canceled on lost of semaphore
+func WithLock(c coordination.Client, nodePath, semaphore string) Option {
+	return func(m *Migrator) {
+		m.lock = &lockConfig{
+			client:    c,
+			nodePath:  nodePath,
+			semaphore: semaphore,
+		}
+	}
+}
+
+// WithDryRun makes migrator to only return migrations which will be applied or reverted
+// without execution of migrations and changes of history table
+func WithDryRun() Option {
+	return func(m *Migrator) {
+		m.dryRun = true
+	}
+}
+
+// New makes migrator of migrations which executes migrations with db (usually query.Client)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(db query.Executor, migrations []Migration, opts ...Option) (*Migrator, error) {
+	m := &Migrator{
+		db:           db,
+		migrations:   append([]Migration(nil), migrations...),
+		historyTable: defaultHistoryTable,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(m)
+		}
+	}
+
+	sort.Slice(m.migrations, func(i, j int) bool {
+		return m.migrations[i].Version < m.migrations[j].Version
+	})
+	for i := 1; i < len(m.migrations); i++ {
+		if m.migrations[i].Version == m.migrations[i-1].Version {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d", errDuplicateVersion, m.migrations[i].Version))
+		}
+	}
+
+	return m, nil
+}
+
+// Applied returns applied migrations from history table in ascending order of versions
+func (m *Migrator) Applied(ctx context.Context) ([]AppliedMigration, error) {
+	applied, err := readHistory(ctx, m.db, m.historyTable)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return applied, nil
+}
+
+// Pending returns not applied migrations in order of apply. Pending checks checksums of applied migrations
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.Applied(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	pending, err := m.pending(applied, ^uint64(0))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return pending, nil
+}
+
+// Up applies all pending migrations. Up returns applied migrations (or migrations to apply in dry run mode)
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	return m.UpTo(ctx, ^uint64(0))
+}
+
+// UpTo applies pending migrations with versions less or equal than version.
+// UpTo returns applied migrations (or migrations to apply in dry run mode)
+func (m *Migrator) UpTo(ctx context.Context, version uint64) (done []Migration, _ error) {
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.Applied(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		pending, err := m.pending(applied, version)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if m.dryRun {
+			done = pending
+
+			return nil
+		}
+
+		for i := range pending {
+			if err = m.db.Exec(ctx, pending[i].Up); err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("apply migration %d_%s failed: %w",
+					pending[i].Version, pending[i].Name, err,
+				))
+			}
+
+			if err = insertHistory(ctx, m.db, m.historyTable, &pending[i]); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			done = append(done, pending[i])
+		}
+
+		return nil
+	})
+	if err != nil {
+		return done, xerrors.WithStackTrace(err)
+	}
+
+	return done, nil
+}
+
+// Down reverts last applied migration. Down returns reverted migrations (or migrations to revert in dry run mode)
+func (m *Migrator) Down(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.Applied(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if len(applied) == 0 {
+			return nil
+		}
+
+		done, err = m.revert(ctx, applied[len(applied)-1:])
+
+		return err
+	})
+	if err != nil {
+		return done, xerrors.WithStackTrace(err)
+	}
+
+	return done, nil
+}
+
+// DownTo reverts applied migrations with versions greater than version in descending order of versions.
+// DownTo returns reverted migrations (or migrations to revert in dry run mode)
+func (m *Migrator) DownTo(ctx context.Context, version uint64) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.Applied(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		i := sort.Search(len(applied), func(i int) bool {
+			return applied[i].Version > version
+		})
+
+		done, err = m.revert(ctx, applied[i:])
+
+		return err
+	})
+	if err != nil {
+		return done, xerrors.WithStackTrace(err)
+	}
+
+	return done, nil
+}
+
+// revert reverts applied migrations in reverse order
+func (m *Migrator) revert(ctx context.Context, applied []AppliedMigration) (done []Migration, _ error) {
+	migrations := make([]*Migration, 0, len(applied))
+	for i := len(applied) - 1; i >= 0; i-- {
+		migration, err := m.lookup(&applied[i])
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if migration.Down == "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d_%s",
+				ErrIrreversibleMigration, migration.Version, migration.Name,
+			))
+		}
+		migrations = append(migrations, migration)
+	}
+
+	for _, migration := range migrations {
+		if !m.dryRun {
+			if err := m.db.Exec(ctx, migration.Down); err != nil {
+				return done, xerrors.WithStackTrace(fmt.Errorf("revert migration %d_%s failed: %w",
+					migration.Version, migration.Name, err,
+				))
+			}
+
+			if err := deleteHistory(ctx, m.db, m.historyTable, migration.Version); err != nil {
+				return done, xerrors.WithStackTrace(err)
+			}
+		}
+
+		done = append(done, *migration)
+	}
+
+	return done, nil
+}
+
+// lookup returns migration of applied migration with checks of checksum
+func (m *Migrator) lookup(applied *AppliedMigration) (*Migration, error) {
+	i := sort.Search(len(m.migrations), func(i int) bool {
+		return m.migrations[i].Version >= applied.Version
+	})
+	if i == len(m.migrations) || m.migrations[i].Version != applied.Version {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d_%s", ErrUnknownMigration, applied.Version, applied.Name))
+	}
+
+	if m.migrations[i].Checksum() != applied.Checksum {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d_%s", ErrChecksumMismatch, applied.Version, applied.Name))
+	}
+
+	return &m.migrations[i], nil
+}
+
+func (m *Migrator) pending(applied []AppliedMigration, version uint64) ([]Migration, error) {
+	versions := make(map[uint64]struct{}, len(applied))
+	for i := range applied {
+		if _, err := m.lookup(&applied[i]); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		versions[applied[i].Version] = struct{}{}
+	}
+
+	var pending []Migration
+	for i := range m.migrations {
+		if m.migrations[i].Version > version {
+			break
+		}
+		if _, has := versions[m.migrations[i].Version]; !has {
+			pending = append(pending, m.migrations[i])
+		}
+	}
+
+	return pending, nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, f func(ctx context.Context) error) (finalErr error) {
+	if m.dryRun {
+		return f(ctx)
+	}
+
+	if m.lock == nil {
+		return m.run(ctx, f)
+	}
+
+	err := m.lock.client.CreateNode(ctx, m.lock.nodePath, coordination.NodeConfig{
+		ReadConsistencyMode:   coordination.ConsistencyModeStrict,
+		AttachConsistencyMode: coordination.ConsistencyModeStrict,
+	})
+	if err != nil && !xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	session, err := m.lock.client.Session(ctx, m.lock.nodePath)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = session.Close(ctx)
+	}()
+
+	lease, err := session.AcquireSemaphore(ctx, m.lock.semaphore, coordination.Exclusive,
+		coordinationOptions.WithEphemeral(true),
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if err := lease.Release(); err != nil && finalErr == nil {
+			finalErr = xerrors.WithStackTrace(err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(lease.Context(), cancel)
+	defer stop()
+
+	return m.run(ctx, f)
+}
+
+func (m *Migrator) run(ctx context.Context, f func(ctx context.Context) error) error {
+	if err := createHistoryTable(ctx, m.db, m.historyTable); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return f(ctx)
+}
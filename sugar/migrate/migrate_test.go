@@ -0,0 +1,333 @@
+package migrate
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	coordinationOptions "github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	testExecutor struct {
+		query.Executor
+
+		historyCreated bool
+		history        map[uint64]AppliedMigration
+		executed       []string
+	}
+	testResultSet struct {
+		query.ResultSet
+	}
+)
+
+func (rs testResultSet) Close(ctx context.Context) error {
+	return nil
+}
+
+func (e *testExecutor) Exec(ctx context.Context, q string, opts ...options.Execute) error {
+	switch {
+	case strings.Contains(q, "CREATE TABLE IF NOT EXISTS `schema_migrations`"):
+		e.historyCreated = true
+	case strings.Contains(q, "UPSERT INTO `schema_migrations`"):
+		var m AppliedMigration
+		options.ExecuteSettings(opts...).Params().Each(func(name string, v value.Value) {
+			switch name {
+			case "$version":
+				_ = value.CastTo(v, &m.Version)
+			case "$name":
+				_ = value.CastTo(v, &m.Name)
+			case "$checksum":
+				_ = value.CastTo(v, &m.Checksum)
+			}
+		})
+		m.AppliedAt = time.Unix(1, 0)
+		e.history[m.Version] = m
+	case strings.Contains(q, "DELETE FROM `schema_migrations`"):
+		var version uint64
+		options.ExecuteSettings(opts...).Params().Each(func(name string, v value.Value) {
+			_ = value.CastTo(v, &version)
+		})
+		delete(e.history, version)
+	default:
+		e.executed = append(e.executed, q)
+	}
+
+	return nil
+}
+
+func (e *testExecutor) QueryResultSet(
+	ctx context.Context, q string, opts ...options.Execute,
+) (query.ClosableResultSet, error) {
+	if !e.historyCreated {
+		return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR))
+	}
+
+	columns := []*Ydb.Column{
+		{Name: "version", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}},
+		{Name: "name", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}},
+		{Name: "checksum", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}},
+		{Name: "applied_at", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_TIMESTAMP}}},
+	}
+	versions := make([]uint64, 0, len(e.history))
+	for version := range e.history {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+	rows := make([]query.Row, 0, len(versions))
+	for _, version := range versions {
+		m := e.history[version]
+		rows = append(rows, internalQuery.NewRow(columns, &Ydb.Value{
+			Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: m.Version}},
+				{Value: &Ydb.Value_TextValue{TextValue: m.Name}},
+				{Value: &Ydb.Value_TextValue{TextValue: m.Checksum}},
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: uint64(m.AppliedAt.UnixMicro())}},
+			},
+		}))
+	}
+
+	return testResultSet{
+		ResultSet: internalQuery.MaterializedResultSet(0,
+			[]string{"version", "name", "checksum", "applied_at"},
+			[]types.Type{types.TypeUint64, types.TypeText, types.TypeText, types.TypeTimestamp},
+			rows,
+		),
+	}, nil
+}
+
+type (
+	testCoordinationClient struct {
+		coordination.Client
+
+		nodes    []string
+		sessions []*testCoordinationSession
+	}
+	testCoordinationSession struct {
+		coordination.Session
+
+		semaphore string
+		count     uint64
+		ephemeral bool
+		released  bool
+		closed    bool
+	}
+	testLease struct {
+		coordination.Lease
+
+		session *testCoordinationSession
+	}
+)
+
+func (c *testCoordinationClient) CreateNode(ctx context.Context, path string, config coordination.NodeConfig) error {
+	c.nodes = append(c.nodes, path)
+
+	return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ALREADY_EXISTS))
+}
+
+func (c *testCoordinationClient) Session(
+	ctx context.Context, path string, opts ...coordinationOptions.SessionOption,
+) (coordination.Session, error) {
+	s := &testCoordinationSession{}
+	c.sessions = append(c.sessions, s)
+
+	return s, nil
+}
+
+func (s *testCoordinationSession) AcquireSemaphore(
+	ctx context.Context, name string, count uint64, opts ...coordinationOptions.AcquireSemaphoreOption,
+) (coordination.Lease, error) {
+	s.semaphore = name
+	s.count = count
+	request := Ydb_Coordination.SessionRequest_AcquireSemaphore{}
+	for _, opt := range opts {
+		opt(&request)
+	}
+	s.ephemeral = request.GetEphemeral()
+
+	return &testLease{session: s}, nil
+}
+
+func (s *testCoordinationSession) Close(ctx context.Context) error {
+	s.closed = true
+
+	return nil
+}
+
+func (l *testLease) Context() context.Context {
+	return context.Background()
+}
+
+func (l *testLease) Release() error {
+	l.session.released = true
+
+	return nil
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 2, Name: "add_index", Up: "up 2", Down: "down 2"},
+		{Version: 1, Name: "init", Up: "up 1", Down: "down 1"},
+		{Version: 3, Name: "add_column", Up: "up 3"},
+	}
+}
+
+func versionsOf(migrations []Migration) (versions []uint64) {
+	for i := range migrations {
+		versions = append(versions, migrations[i].Version)
+	}
+
+	return versions
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("DuplicateVersion", func(t *testing.T) {
+		_, err := New(&testExecutor{}, append(testMigrations(), Migration{Version: 1, Up: "up"}))
+		require.ErrorIs(t, err, errDuplicateVersion)
+	})
+	t.Run("UpAndDown", func(t *testing.T) {
+		db := &testExecutor{history: map[uint64]AppliedMigration{}}
+		m, err := New(db, testMigrations())
+		require.NoError(t, err)
+
+		done, err := m.UpTo(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, versionsOf(done))
+		require.Equal(t, []string{"up 1", "up 2"}, db.executed)
+
+		pending, err := m.Pending(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3}, versionsOf(pending))
+
+		done, err = m.Up(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3}, versionsOf(done))
+		applied, err := m.Applied(ctx)
+		require.NoError(t, err)
+		require.Len(t, applied, 3)
+		require.Equal(t, "add_column", applied[2].Name)
+
+		// nothing to apply
+		done, err = m.Up(ctx)
+		require.NoError(t, err)
+		require.Empty(t, done)
+
+		// migration 3 has no down statements
+		_, err = m.Down(ctx)
+		require.ErrorIs(t, err, ErrIrreversibleMigration)
+
+		delete(db.history, 3)
+		db.executed = nil
+		done, err = m.DownTo(ctx, 0)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2, 1}, versionsOf(done))
+		require.Equal(t, []string{"down 2", "down 1"}, db.executed)
+		require.Empty(t, db.history)
+	})
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		db := &testExecutor{history: map[uint64]AppliedMigration{}}
+		m, err := New(db, testMigrations())
+		require.NoError(t, err)
+		_, err = m.UpTo(ctx, 1)
+		require.NoError(t, err)
+
+		changed := testMigrations()
+		changed[1].Up = "changed up 1"
+		m, err = New(db, changed)
+		require.NoError(t, err)
+		_, err = m.Up(ctx)
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+		require.Equal(t, []string{"up 1"}, db.executed)
+	})
+	t.Run("UnknownMigration", func(t *testing.T) {
+		db := &testExecutor{history: map[uint64]AppliedMigration{}}
+		m, err := New(db, testMigrations())
+		require.NoError(t, err)
+		_, err = m.Up(ctx)
+		require.NoError(t, err)
+
+		m, err = New(db, testMigrations()[:2])
+		require.NoError(t, err)
+		_, err = m.Pending(ctx)
+		require.ErrorIs(t, err, ErrUnknownMigration)
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		db := &testExecutor{history: map[uint64]AppliedMigration{}}
+		m, err := New(db, testMigrations(), WithDryRun())
+		require.NoError(t, err)
+		done, err := m.Up(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 3}, versionsOf(done))
+		require.False(t, db.historyCreated)
+		require.Empty(t, db.executed)
+		require.Empty(t, db.history)
+	})
+	t.Run("Lock", func(t *testing.T) {
+		db := &testExecutor{history: map[uint64]AppliedMigration{}}
+		c := &testCoordinationClient{}
+		m, err := New(db, testMigrations(), WithLock(c, "/local/migrations", "lock"))
+		require.NoError(t, err)
+		_, err = m.Up(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"/local/migrations"}, c.nodes)
+		require.Len(t, c.sessions, 1)
+		require.Equal(t, "lock", c.sessions[0].semaphore)
+		require.Equal(t, uint64(coordination.Exclusive), c.sessions[0].count)
+		require.True(t, c.sessions[0].ephemeral)
+		require.True(t, c.sessions[0].released)
+		require.True(t, c.sessions[0].closed)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("HappyWay", func(t *testing.T) {
+		migrations, err := Load(fstest.MapFS{
+			"migrations/002_add_index.up.sql":   {Data: []byte("up 2")},
+			"migrations/002_add_index.down.sql": {Data: []byte("down 2")},
+			"migrations/001_init.up.sql":        {Data: []byte("up 1")},
+			"migrations/README.md":              {Data: []byte("readme")},
+		}, "migrations")
+		require.NoError(t, err)
+		require.Equal(t, []Migration{
+			{Version: 1, Name: "init", Up: "up 1"},
+			{Version: 2, Name: "add_index", Up: "up 2", Down: "down 2"},
+		}, migrations)
+	})
+	t.Run("InvalidFileName", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"migrations/init.up.sql": {Data: []byte("up")},
+		}, "migrations")
+		require.ErrorIs(t, err, errInvalidFileName)
+	})
+	t.Run("NoUpFile", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"migrations/001_init.down.sql": {Data: []byte("down")},
+		}, "migrations")
+		require.ErrorIs(t, err, errNoUpFile)
+	})
+	t.Run("DuplicateVersion", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"migrations/001_init.up.sql":  {Data: []byte("up")},
+			"migrations/001_other.up.sql": {Data: []byte("up")},
+		}, "migrations")
+		require.ErrorIs(t, err, errDuplicateVersion)
+	})
+}
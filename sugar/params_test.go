@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -222,6 +223,27 @@ func TestGenerateDeclareSection_ParameterOption(t *testing.T) {
 	}
 }
 
+func TestGenerateDeclareSection_FromStruct(t *testing.T) {
+	type row struct {
+		ID   uint64  `sql:"id"`
+		Name *string `sql:"name"`
+	}
+	parameters, err := params.FromStruct(struct {
+		Rows    []row      `sql:"rows"`
+		Updated *time.Time `sql:"updated_at"`
+	}{
+		Rows: []row{{ID: 1}},
+	})
+	require.NoError(t, err)
+	declares, err := GenerateDeclareSection(parameters)
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"DECLARE $rows AS List<Struct<'id':Uint64,'name':Optional<Utf8>>>;\n"+
+		"DECLARE $updated_at AS Optional<Timestamp>;\n",
+		declares,
+	)
+}
+
 func TestGenerateDeclareSection_NamedArg(t *testing.T) {
 	b := testutil.QueryBind(bind.AutoDeclare{})
 	getDeclares := func(declaresSection string) (declares []string) {
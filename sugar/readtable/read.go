@@ -0,0 +1,355 @@
+// Package readtable reads whole table with parallel streams over partitions of table
+package readtable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+const defaultConcurrency = 4
+
+var errNoKeyColumn = errors.New("primary key column not found in result set")
+
+type (
+	config struct {
+		concurrency int
+		ordered     bool
+		readOptions []options.ReadTableOption
+	}
+	Option func(c *config)
+
+	// keyRange is a range of primary key which read with one stream
+	keyRange struct {
+		options.KeyRange
+
+		// last is a primary key of last read row of range
+		last value.Value
+	}
+	chunk struct {
+		rows []query.Row
+		err  error
+	}
+)
+
+// WithConcurrency sets max count of concurrently read partitions. Default is 4
+func WithConcurrency(concurrency int) Option {
+	return func(c *config) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// WithOrdered makes Read to return rows in order of primary key.
+// Partitions still read concurrently, but rows of next partitions are buffered until
+// previous partitions are read
+func WithOrdered() Option {
+	return func(c *config) {
+		c.ordered = true
+	}
+}
+
+// WithReadOptions appends options of each partition read stream (columns, snapshot and other).
+// Key range options are not supported because Read sets key range of each stream from partition bounds
+func WithReadOptions(opts ...options.ReadTableOption) Option {
+	return func(c *config) {
+		c.readOptions = append(c.readOptions, opts...)
+	}
+}
+
+// Read reads table with path concurrently by partitions of table. Read splits key space of table
+// on partition bounds (DescribeTable with options.WithShardKeyBounds) and reads each key range
+// with StreamReadTable. On retryable errors read of key range continues from last read key.
+//
+// Primary key columns always read for continue the read of key range, so rows contains
+// primary key columns even if options.ReadColumns not contains them.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Read(ctx context.Context, c table.Client, path string, opts ...Option) xiter.Seq2[query.Row, error] {
+	cfg := config{
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	return func(yield func(query.Row, error) bool) {
+		var desc options.Description
+		err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			desc, err = s.DescribeTable(ctx, path, options.WithShardKeyBounds())
+
+			return err
+		}, table.WithIdempotent())
+		if err != nil {
+			yield(nil, xerrors.WithStackTrace(err))
+
+			return
+		}
+
+		r := &reader{
+			client:      c,
+			path:        path,
+			primaryKey:  desc.PrimaryKey,
+			readOptions: cfg.readOptions,
+		}
+		if columns := readColumns(cfg.readOptions); len(columns) > 0 {
+			r.readOptions = append(r.readOptions, options.ReadColumns(missing(desc.PrimaryKey, columns)...))
+		}
+
+		ranges := make([]*keyRange, 0, len(desc.KeyRanges))
+		for i := range desc.KeyRanges {
+			ranges = append(ranges, &keyRange{KeyRange: desc.KeyRanges[i]})
+		}
+		if len(ranges) == 0 {
+			ranges = append(ranges, &keyRange{})
+		}
+
+		r.run(ctx, ranges, cfg.concurrency, cfg.ordered, yield)
+	}
+}
+
+type reader struct {
+	client      table.Client
+	path        string
+	primaryKey  []string
+	readOptions []options.ReadTableOption
+}
+
+func (r *reader) run(
+	ctx context.Context, ranges []*keyRange, concurrency int, ordered bool, yield func(query.Row, error) bool,
+) {
+	parentCtx := ctx
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		wg      sync.WaitGroup
+		outputs = make([]chan chunk, len(ranges))
+		next    = make(chan int, len(ranges))
+	)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	if ordered {
+		for i := range outputs {
+			outputs[i] = make(chan chunk, 1)
+		}
+	} else {
+		output := make(chan chunk, concurrency)
+		for i := range outputs {
+			outputs[i] = output
+		}
+	}
+
+	for i := range ranges {
+		next <- i
+	}
+	close(next)
+
+	var done sync.WaitGroup
+	done.Add(len(ranges))
+	for i := 0; i < concurrency && i < len(ranges); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				r.readRange(ctx, ranges[i], outputs[i])
+				if ordered {
+					close(outputs[i])
+				}
+				done.Done()
+			}
+		}()
+	}
+
+	if !ordered {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			done.Wait()
+			close(outputs[0])
+		}()
+		if drain(outputs[0], yield) && parentCtx.Err() != nil {
+			yield(nil, xerrors.WithStackTrace(parentCtx.Err()))
+		}
+
+		return
+	}
+
+	for i := range outputs {
+		if !drain(outputs[i], yield) {
+			return
+		}
+	}
+	if parentCtx.Err() != nil {
+		yield(nil, xerrors.WithStackTrace(parentCtx.Err()))
+	}
+}
+
+// drain yields rows of chunks from output. drain returns false if yield returns false or chunk contains error
+func drain(output <-chan chunk, yield func(query.Row, error) bool) bool {
+	for c := range output {
+		if c.err != nil {
+			yield(nil, c.err)
+
+			return false
+		}
+		for _, row := range c.rows {
+			if !yield(row, nil) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// readRange reads rows of key range with retries and sends rows to output.
+// Each retry attempt continues read from last read key of key range
+func (r *reader) readRange(ctx context.Context, kr *keyRange, output chan<- chunk) {
+	err := r.client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		return r.readStream(ctx, s, kr, output)
+	}, table.WithIdempotent())
+	if err != nil && ctx.Err() == nil {
+		select {
+		case output <- chunk{err: xerrors.WithStackTrace(err)}:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (r *reader) readStream(ctx context.Context, s table.Session, kr *keyRange, output chan<- chunk) (
+	finalErr error,
+) {
+	opts := append(append(make([]options.ReadTableOption, 0, len(r.readOptions)+3), r.readOptions...),
+		options.ReadOrdered(),
+	)
+	switch {
+	case kr.last != nil:
+		opts = append(opts, options.ReadGreater(kr.last))
+	case kr.From != nil:
+		opts = append(opts, options.ReadGreaterOrEqual(kr.From))
+	}
+	if kr.To != nil {
+		opts = append(opts, options.ReadLess(kr.To))
+	}
+
+	res, err := s.StreamReadTable(ctx, r.path, opts...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil && finalErr == nil {
+			finalErr = xerrors.WithStackTrace(err)
+		}
+	}()
+
+	for res.NextResultSet(ctx) {
+		rows, last, err := r.scanResultSet(res)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		select {
+		case output <- chunk{rows: rows}:
+			kr.last = last
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		}
+	}
+
+	if err := res.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// scanResultSet converts rows of current result set to query.Row and returns primary key of last row
+func (r *reader) scanResultSet(res result.StreamResult) (rows []query.Row, last value.Value, _ error) {
+	columns, items, err := scanner.RawRows(res)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	key := make([]int, 0, len(r.primaryKey))
+	for _, name := range r.primaryKey {
+		idx := -1
+		for i := range columns {
+			if columns[i].GetName() == name {
+				idx = i
+
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errNoKeyColumn, name))
+		}
+		key = append(key, idx)
+	}
+
+	if len(items) == 0 {
+		return nil, nil, nil
+	}
+
+	rows = make([]query.Row, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, internalQuery.NewRow(columns, item))
+	}
+
+	lastItems := items[len(items)-1].GetItems()
+	keyValues := make([]value.Value, len(key))
+	for i, idx := range key {
+		keyValues[i] = value.FromYDB(columns[idx].GetType(), lastItems[idx])
+	}
+
+	return rows, value.TupleValue(keyValues...), nil
+}
+
+// readColumns returns columns from read options
+func readColumns(opts []options.ReadTableOption) []string {
+	desc := options.ReadTableDesc{}
+	a := allocator.New()
+	defer a.Free()
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyReadTableOption(&desc, a)
+		}
+	}
+
+	return desc.Columns
+}
+
+// missing returns names which not contains in columns
+func missing(names, columns []string) (result []string) {
+	has := make(map[string]struct{}, len(columns))
+	for _, column := range columns {
+		has[column] = struct{}{}
+	}
+	for _, name := range names {
+		if _, ok := has[name]; !ok {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
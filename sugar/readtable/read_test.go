@@ -0,0 +1,227 @@
+package readtable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+var errTestRetryable = errors.New("test retryable error")
+
+type (
+	testClient struct {
+		table.Client
+
+		session *testSession
+	}
+	testSession struct {
+		table.Session
+
+		rows      uint64
+		bounds    []uint64
+		setSize   uint64
+		failAfter map[uint64]int // from bound of range -> count of result sets before fail
+
+		mu       sync.Mutex
+		requests []string
+	}
+)
+
+func (c *testClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	for {
+		err := op(ctx, c.session)
+		if !errors.Is(err, errTestRetryable) {
+			return err
+		}
+	}
+}
+
+func (s *testSession) DescribeTable(
+	ctx context.Context, path string, opts ...options.DescribeTableOption,
+) (desc options.Description, _ error) {
+	desc.PrimaryKey = []string{"id"}
+	var from value.Value
+	for _, bound := range s.bounds {
+		to := value.TupleValue(value.OptionalValue(value.Uint64Value(bound)))
+		desc.KeyRanges = append(desc.KeyRanges, options.KeyRange{From: from, To: to})
+		from = to
+	}
+	desc.KeyRanges = append(desc.KeyRanges, options.KeyRange{From: from})
+
+	return desc, nil
+}
+
+func (s *testSession) StreamReadTable(
+	ctx context.Context, path string, opts ...options.ReadTableOption,
+) (result.StreamResult, error) {
+	var (
+		desc = options.ReadTableDesc{}
+		a    = allocator.New()
+	)
+	defer a.Free()
+	for _, opt := range opts {
+		opt.ApplyReadTableOption(&desc, a)
+	}
+
+	from, to := uint64(0), s.rows
+	switch bound := desc.KeyRange.GetFromBound().(type) {
+	case *Ydb_Table.KeyRange_Greater:
+		from = bound.Greater.GetValue().GetItems()[0].GetUint64Value() + 1
+	case *Ydb_Table.KeyRange_GreaterOrEqual:
+		from = bound.GreaterOrEqual.GetValue().GetItems()[0].GetUint64Value()
+	}
+	if bound, ok := desc.KeyRange.GetToBound().(*Ydb_Table.KeyRange_Less); ok {
+		to = bound.Less.GetValue().GetItems()[0].GetUint64Value()
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, fmt.Sprintf("[%d,%d) %v", from, to, desc.Columns))
+	failAfter, fail := s.failAfter[from]
+	delete(s.failAfter, from)
+	s.mu.Unlock()
+
+	var sets []*Ydb.ResultSet
+	for id := from; id < to; id += s.setSize {
+		set := &Ydb.ResultSet{
+			Columns: []*Ydb.Column{
+				{Name: "id", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}},
+				{Name: "value", Type: &Ydb.Type{
+					Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+						Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+					}},
+				}},
+			},
+		}
+		for i := id; i < id+s.setSize && i < to; i++ {
+			set.Rows = append(set.Rows, &Ydb.Value{Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: i}},
+				{Value: &Ydb.Value_TextValue{TextValue: fmt.Sprintf("value-%d", i)}},
+			}})
+		}
+		sets = append(sets, set)
+	}
+
+	return scanner.NewStream(ctx,
+		func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+			if fail && failAfter == 0 {
+				return nil, nil, errTestRetryable
+			}
+			failAfter--
+			if len(sets) == 0 {
+				return nil, nil, io.EOF
+			}
+			set := sets[0]
+			sets = sets[1:]
+
+			return set, nil, nil
+		},
+		func(err error) error {
+			return nil
+		},
+	)
+}
+
+func readIDs(t *testing.T, seq func(yield func(query.Row, error) bool)) (ids []uint64) {
+	seq(func(row query.Row, err error) bool {
+		require.NoError(t, err)
+		var (
+			id uint64
+			v  *string
+		)
+		require.NoError(t, row.ScanNamed(query.Named("id", &id), query.Named("value", &v)))
+		require.Equal(t, fmt.Sprintf("value-%d", id), *v)
+		ids = append(ids, id)
+
+		return true
+	})
+
+	return ids
+}
+
+func expectedIDs(n uint64) (ids []uint64) {
+	for i := uint64(0); i < n; i++ {
+		ids = append(ids, i)
+	}
+
+	return ids
+}
+
+func TestRead(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("Unordered", func(t *testing.T) {
+		s := &testSession{rows: 100, bounds: []uint64{25, 50, 75}, setSize: 10}
+		ids := readIDs(t, Read(ctx, &testClient{session: s}, "/local/test", WithConcurrency(2)))
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+		require.Equal(t, expectedIDs(100), ids)
+		require.Len(t, s.requests, 4)
+	})
+	t.Run("Ordered", func(t *testing.T) {
+		s := &testSession{rows: 100, bounds: []uint64{25, 50, 75}, setSize: 10}
+		ids := readIDs(t, Read(ctx, &testClient{session: s}, "/local/test", WithConcurrency(3), WithOrdered()))
+		require.Equal(t, expectedIDs(100), ids)
+	})
+	t.Run("ResumeAfterRetryableError", func(t *testing.T) {
+		s := &testSession{
+			rows:      100,
+			bounds:    []uint64{50},
+			setSize:   10,
+			failAfter: map[uint64]int{50: 2},
+		}
+		ids := readIDs(t, Read(ctx, &testClient{session: s}, "/local/test", WithOrdered(),
+			WithReadOptions(options.ReadColumns("value")),
+		))
+		require.Equal(t, expectedIDs(100), ids)
+		sort.Strings(s.requests)
+		require.Equal(t, []string{
+			"[0,50) [value id]",
+			"[50,100) [value id]",
+			"[70,100) [value id]",
+		}, s.requests)
+	})
+	t.Run("Break", func(t *testing.T) {
+		s := &testSession{rows: 100, bounds: []uint64{25, 50, 75}, setSize: 1}
+		var count int
+		Read(ctx, &testClient{session: s}, "/local/test")(func(row query.Row, err error) bool {
+			require.NoError(t, err)
+			count++
+
+			return count < 10
+		})
+		require.Equal(t, 10, count)
+	})
+	t.Run("CanceledContext", func(t *testing.T) {
+		s := &testSession{rows: 10, setSize: 1}
+		childCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		var errs []error
+		Read(childCtx, &testClient{session: s}, "/local/test")(func(row query.Row, err error) bool {
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			return true
+		})
+		require.NotEmpty(t, errs)
+		require.ErrorIs(t, errs[len(errs)-1], context.Canceled)
+	})
+}
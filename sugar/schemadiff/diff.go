@@ -0,0 +1,719 @@
+// Package schemadiff compares declarative description of table with live description of table
+// and plans changes of table schema as AlterTable options or YQL statements
+package schemadiff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+// ErrUnsupportedStep returned when plan contains step which cannot be applied with AlterTable request
+// (or expressed with ALTER TABLE statement), such as change of primary key or type of column
+var ErrUnsupportedStep = errors.New("schema change is not supported by alter table")
+
+// Action is a kind of schema change
+type Action int
+
+const (
+	ActionUnknown Action = iota
+	// ActionAlterPrimaryKey is a change of primary key. Requires recreation of table
+	ActionAlterPrimaryKey
+	// ActionAlterColumnType is a change of column type. Requires recreation of column or table
+	ActionAlterColumnType
+	ActionDropIndex
+	ActionDropChangefeed
+	ActionAddColumnFamily
+	ActionAlterColumnFamily
+	ActionAddColumn
+	ActionSetColumnFamily
+	ActionDropColumn
+	ActionAddIndex
+	ActionSetTimeToLive
+	ActionDropTimeToLive
+	ActionAlterPartitioningSettings
+	ActionAddAttribute
+	ActionAlterAttribute
+	ActionDropAttribute
+	ActionAddChangefeed
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionAlterPrimaryKey:
+		return "alter primary key"
+	case ActionAlterColumnType:
+		return "alter column type"
+	case ActionDropIndex:
+		return "drop index"
+	case ActionDropChangefeed:
+		return "drop changefeed"
+	case ActionAddColumnFamily:
+		return "add column family"
+	case ActionAlterColumnFamily:
+		return "alter column family"
+	case ActionAddColumn:
+		return "add column"
+	case ActionSetColumnFamily:
+		return "set column family"
+	case ActionDropColumn:
+		return "drop column"
+	case ActionAddIndex:
+		return "add index"
+	case ActionSetTimeToLive:
+		return "set time to live"
+	case ActionDropTimeToLive:
+		return "drop time to live"
+	case ActionAlterPartitioningSettings:
+		return "alter partitioning settings"
+	case ActionAddAttribute:
+		return "add attribute"
+	case ActionAlterAttribute:
+		return "alter attribute"
+	case ActionDropAttribute:
+		return "drop attribute"
+	case ActionAddChangefeed:
+		return "add changefeed"
+	default:
+		return fmt.Sprintf("unknown action %d", a)
+	}
+}
+
+func (a Action) isAttribute() bool {
+	switch a {
+	case ActionAddAttribute, ActionAlterAttribute, ActionDropAttribute:
+		return true
+	default:
+		return false
+	}
+}
+
+type (
+	// Step is a single schema change of table
+	Step struct {
+		Action Action
+
+		// Name is a name of changed column, index, column family, attribute or changefeed
+		Name string
+
+		// Destructive is true for steps which can lose data or require recreation of table
+		Destructive bool
+
+		// Option is an option of AlterTable request which applies step.
+		// Option is nil if step cannot be applied with AlterTable request
+		Option options.AlterTableOption
+
+		// YQL is an ALTER TABLE statement which applies step.
+		// YQL is empty if step cannot be expressed with YQL statement (for example, attribute steps)
+		YQL string
+
+		// family is an added column family of ActionAddColumnFamily step
+		family options.ColumnFamily
+	}
+
+	// Plan is a list of steps which changes current schema of table to desired schema.
+	// Steps are ordered to be applicable one by one: for example, indexes are dropped
+	// before dropping of indexed columns and added after adding of new columns
+	Plan struct {
+		Path  string
+		Steps []Step
+	}
+)
+
+func (s *Step) String() string {
+	if s.Destructive {
+		return s.title() + " (destructive)"
+	}
+
+	return s.title()
+}
+
+func (s *Step) title() string {
+	if s.Name == "" {
+		return s.Action.String()
+	}
+
+	return s.Action.String() + " " + s.Name
+}
+
+// Empty checks current schema of table is equal to desired schema
+func (p *Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// Destructive returns steps which can lose data or require recreation of table
+func (p *Plan) Destructive() (steps []Step) {
+	for i := range p.Steps {
+		if p.Steps[i].Destructive {
+			steps = append(steps, p.Steps[i])
+		}
+	}
+
+	return steps
+}
+
+// Options returns options for table.Session.AlterTable call which applies plan.
+// Added column families of all steps are joined into single options.WithAddColumnFamilies option,
+// because each options.WithAddColumnFamilies option replaces list of added column families.
+// Options returns error ErrUnsupportedStep if some step cannot be applied with AlterTable request
+func (p *Plan) Options() ([]options.AlterTableOption, error) {
+	var (
+		opts     = make([]options.AlterTableOption, 0, len(p.Steps))
+		families []options.ColumnFamily
+		familyAt = -1
+	)
+	for i := range p.Steps {
+		if p.Steps[i].Option == nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedStep, p.Steps[i].String()))
+		}
+		if p.Steps[i].Action == ActionAddColumnFamily && p.Steps[i].family.Name != "" {
+			if familyAt < 0 {
+				familyAt = len(opts)
+				opts = append(opts, nil)
+			}
+			families = append(families, p.Steps[i].family)
+
+			continue
+		}
+		opts = append(opts, p.Steps[i].Option)
+	}
+	if familyAt >= 0 {
+		opts[familyAt] = options.WithAddColumnFamilies(families...)
+	}
+
+	return opts, nil
+}
+
+// YQL returns ALTER TABLE statements which applies plan.
+// User attributes of table cannot be changed with YQL, so attribute steps are skipped
+// and must be applied with AlterTable request (see Options and Step.Option).
+// YQL returns error ErrUnsupportedStep if some other step cannot be expressed with YQL statement
+func (p *Plan) YQL() ([]string, error) {
+	statements := make([]string, 0, len(p.Steps))
+	for i := range p.Steps {
+		if p.Steps[i].Action.isAttribute() {
+			continue
+		}
+		if p.Steps[i].YQL == "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsupportedStep, p.Steps[i].String()))
+		}
+		statements = append(statements, p.Steps[i].YQL)
+	}
+
+	return statements, nil
+}
+
+// String returns plan as human-readable list of steps for dry run
+func (p *Plan) String() string {
+	var buffer strings.Builder
+	buffer.WriteString(p.Path)
+	if p.Empty() {
+		buffer.WriteString(": no changes\n")
+
+		return buffer.String()
+	}
+	buffer.WriteString(":\n")
+	for i := range p.Steps {
+		buffer.WriteString("  ")
+		if p.Steps[i].Destructive {
+			buffer.WriteString("! ")
+		} else {
+			buffer.WriteString("  ")
+		}
+		buffer.WriteString(p.Steps[i].title())
+		buffer.WriteByte('\n')
+	}
+
+	return buffer.String()
+}
+
+// PlanTable describes table with path and makes plan of changes from current schema to desired schema
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func PlanTable(ctx context.Context, c table.Client, path string, desired options.Description) (*Plan, error) {
+	var current options.Description
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		current, err = s.DescribeTable(ctx, path)
+
+		return err
+	}, table.WithIdempotent())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return Diff(path, current, desired), nil
+}
+
+// Diff compares current description of table with desired description and makes plan of changes.
+//
+// Columns, indexes, changefeeds and time to live settings of desired description are declarative:
+// missing in desired description objects will be dropped. Other settings are compared only if
+// specified in desired description: empty primary key, nil attributes, zero fields of partitioning
+// settings and column families means "leave as is". Existing column families are never dropped.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Diff(path string, current, desired options.Description) *Plan {
+	d := differ{
+		path:    path,
+		current: &current,
+		desired: &desired,
+	}
+
+	d.diffPrimaryKey()
+	d.diffColumnTypes()
+	d.dropIndexes()
+	d.dropChangefeeds()
+	d.diffColumnFamilies()
+	d.addColumns()
+	d.setColumnFamilies()
+	d.dropColumns()
+	d.addIndexes()
+	d.diffTimeToLive()
+	d.diffPartitioningSettings()
+	d.diffAttributes()
+	d.addChangefeeds()
+
+	return &Plan{
+		Path:  path,
+		Steps: d.steps,
+	}
+}
+
+type differ struct {
+	path    string
+	current *options.Description
+	desired *options.Description
+	steps   []Step
+}
+
+func (d *differ) add(step Step) {
+	d.steps = append(d.steps, step)
+}
+
+func (d *differ) alterTable(action string) string {
+	return "ALTER TABLE " + quote(d.path) + " " + action + ";"
+}
+
+func (d *differ) diffPrimaryKey() {
+	if len(d.desired.PrimaryKey) == 0 || equalStrings(d.current.PrimaryKey, d.desired.PrimaryKey) {
+		return
+	}
+	d.add(Step{
+		Action: ActionAlterPrimaryKey,
+		Name: "(" + strings.Join(d.current.PrimaryKey, ", ") + ") -> (" +
+			strings.Join(d.desired.PrimaryKey, ", ") + ")",
+		Destructive: true,
+	})
+}
+
+func (d *differ) diffColumnTypes() {
+	current := columnsByName(d.current.Columns)
+	for _, c := range d.desired.Columns {
+		if cc, has := current[c.Name]; has && !types.Equal(cc.Type, c.Type) {
+			d.add(Step{
+				Action:      ActionAlterColumnType,
+				Name:        c.Name + " " + cc.Type.Yql() + " -> " + c.Type.Yql(),
+				Destructive: true,
+			})
+		}
+	}
+}
+
+func (d *differ) addColumns() {
+	current := columnsByName(d.current.Columns)
+	for _, c := range d.desired.Columns {
+		if _, has := current[c.Name]; has {
+			continue
+		}
+		action := "ADD COLUMN " + quote(c.Name) + " " + columnType(c.Type)
+		if c.Family != "" {
+			action += " FAMILY " + quote(c.Family)
+		}
+		d.add(Step{
+			Action: ActionAddColumn,
+			Name:   c.Name,
+			Option: options.WithAddColumnMeta(c),
+			YQL:    d.alterTable(action),
+		})
+	}
+}
+
+func (d *differ) setColumnFamilies() {
+	current := columnsByName(d.current.Columns)
+	for _, c := range d.desired.Columns {
+		cc, has := current[c.Name]
+		if !has || c.Family == "" || familyName(c.Family) == familyName(cc.Family) {
+			continue
+		}
+		d.add(Step{
+			Action: ActionSetColumnFamily,
+			Name:   c.Name,
+			Option: options.WithSetColumnFamily(c.Name, c.Family),
+			YQL:    d.alterTable("ALTER COLUMN " + quote(c.Name) + " SET FAMILY " + quote(c.Family)),
+		})
+	}
+}
+
+func (d *differ) dropColumns() {
+	desired := columnsByName(d.desired.Columns)
+	for _, c := range d.current.Columns {
+		if _, has := desired[c.Name]; has {
+			continue
+		}
+		d.add(Step{
+			Action:      ActionDropColumn,
+			Name:        c.Name,
+			Destructive: true,
+			Option:      options.WithDropColumn(c.Name),
+			YQL:         d.alterTable("DROP COLUMN " + quote(c.Name)),
+		})
+	}
+}
+
+func (d *differ) dropIndexes() {
+	desired := indexesByName(d.desired.Indexes)
+	for _, idx := range d.current.Indexes {
+		if di, has := desired[idx.Name]; has && equalIndexes(&idx, &di) {
+			continue
+		}
+		d.add(Step{
+			Action: ActionDropIndex,
+			Name:   idx.Name,
+			Option: options.WithDropIndex(idx.Name),
+			YQL:    d.alterTable("DROP INDEX " + quote(idx.Name)),
+		})
+	}
+}
+
+func (d *differ) addIndexes() {
+	current := indexesByName(d.current.Indexes)
+	for _, idx := range d.desired.Indexes {
+		if ci, has := current[idx.Name]; has && equalIndexes(&ci, &idx) {
+			continue
+		}
+		d.add(Step{
+			Action: ActionAddIndex,
+			Name:   idx.Name,
+			Option: options.WithAddIndex(idx.Name,
+				options.WithIndexType(idx.Type),
+				options.WithIndexColumns(idx.IndexColumns...),
+				options.WithDataColumns(idx.DataColumns...),
+			),
+			YQL: d.alterTable("ADD INDEX " + quote(idx.Name) + " " + indexType(idx.Type) +
+				" ON (" + quoteAll(idx.IndexColumns) + ")" + cover(idx.DataColumns)),
+		})
+	}
+}
+
+func (d *differ) diffColumnFamilies() {
+	current := make(map[string]options.ColumnFamily, len(d.current.ColumnFamilies))
+	for _, cf := range d.current.ColumnFamilies {
+		current[cf.Name] = cf
+	}
+	for _, cf := range d.desired.ColumnFamilies {
+		cc, has := current[cf.Name]
+		if !has {
+			step := Step{
+				Action: ActionAddColumnFamily,
+				Name:   cf.Name,
+				Option: options.WithAddColumnFamilies(cf),
+				family: cf,
+			}
+			if settings, ok := familySettings(&cf, " = "); ok {
+				step.YQL = d.alterTable("ADD FAMILY " + quote(cf.Name) + " (" + strings.Join(settings, ", ") + ")")
+			}
+			d.add(step)
+
+			continue
+		}
+		changes := changedFamilySettings(&cc, &cf)
+		if changes.Name == "" {
+			continue
+		}
+		step := Step{
+			Action: ActionAlterColumnFamily,
+			Name:   cf.Name,
+			Option: options.WithAlterColumnFamilySettings(changes),
+		}
+		if settings, ok := familySettings(&changes, " "); ok {
+			actions := make([]string, 0, len(settings))
+			for _, setting := range settings {
+				actions = append(actions, "ALTER FAMILY "+quote(cf.Name)+" SET "+setting)
+			}
+			step.YQL = d.alterTable(strings.Join(actions, ", "))
+		}
+		d.add(step)
+	}
+}
+
+func (d *differ) diffTimeToLive() {
+	switch current, desired := d.current.TimeToLiveSettings, d.desired.TimeToLiveSettings; {
+	case desired == nil && current != nil:
+		d.add(Step{
+			Action: ActionDropTimeToLive,
+			Name:   current.ColumnName,
+			Option: options.WithDropTimeToLive(),
+			YQL:    d.alterTable("RESET (TTL)"),
+		})
+	case desired != nil && (current == nil || !equalTimeToLive(current, desired)):
+		d.add(Step{
+			Action: ActionSetTimeToLive,
+			Name:   desired.ColumnName,
+			Option: options.WithSetTimeToLiveSettings(*desired),
+			YQL:    d.alterTable("SET (TTL = " + timeToLive(desired) + ")"),
+		})
+	}
+}
+
+func (d *differ) diffPartitioningSettings() {
+	var (
+		current  = d.current.PartitioningSettings
+		desired  = d.desired.PartitioningSettings
+		merged   = current
+		settings []string
+	)
+	if desired.PartitioningBySize != options.FeatureFlag(0) && desired.PartitioningBySize != current.PartitioningBySize {
+		merged.PartitioningBySize = desired.PartitioningBySize
+		settings = append(settings, "AUTO_PARTITIONING_BY_SIZE = "+featureFlag(desired.PartitioningBySize))
+	}
+	if desired.PartitionSizeMb != 0 && desired.PartitionSizeMb != current.PartitionSizeMb {
+		merged.PartitionSizeMb = desired.PartitionSizeMb
+		settings = append(settings, fmt.Sprintf("AUTO_PARTITIONING_PARTITION_SIZE_MB = %d", desired.PartitionSizeMb))
+	}
+	if desired.PartitioningByLoad != options.FeatureFlag(0) && desired.PartitioningByLoad != current.PartitioningByLoad {
+		merged.PartitioningByLoad = desired.PartitioningByLoad
+		settings = append(settings, "AUTO_PARTITIONING_BY_LOAD = "+featureFlag(desired.PartitioningByLoad))
+	}
+	if desired.MinPartitionsCount != 0 && desired.MinPartitionsCount != current.MinPartitionsCount {
+		merged.MinPartitionsCount = desired.MinPartitionsCount
+		settings = append(settings,
+			fmt.Sprintf("AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = %d", desired.MinPartitionsCount),
+		)
+	}
+	if desired.MaxPartitionsCount != 0 && desired.MaxPartitionsCount != current.MaxPartitionsCount {
+		merged.MaxPartitionsCount = desired.MaxPartitionsCount
+		settings = append(settings,
+			fmt.Sprintf("AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = %d", desired.MaxPartitionsCount),
+		)
+	}
+	if len(settings) == 0 {
+		return
+	}
+	d.add(Step{
+		Action: ActionAlterPartitioningSettings,
+		Option: options.WithAlterPartitionSettingsObject(merged),
+		YQL:    d.alterTable("SET (" + strings.Join(settings, ", ") + ")"),
+	})
+}
+
+func (d *differ) diffAttributes() {
+	if d.desired.Attributes == nil {
+		return
+	}
+	for _, key := range sortedKeys(d.desired.Attributes) {
+		value := d.desired.Attributes[key]
+		current, has := d.current.Attributes[key]
+		switch {
+		case !has:
+			d.add(Step{
+				Action: ActionAddAttribute,
+				Name:   key,
+				Option: options.WithAddAttribute(key, value),
+			})
+		case current != value:
+			d.add(Step{
+				Action: ActionAlterAttribute,
+				Name:   key,
+				Option: options.WithAlterAttribute(key, value),
+			})
+		}
+	}
+	for _, key := range sortedKeys(d.current.Attributes) {
+		if _, has := d.desired.Attributes[key]; !has {
+			d.add(Step{
+				Action: ActionDropAttribute,
+				Name:   key,
+				Option: options.WithDropAttribute(key),
+			})
+		}
+	}
+}
+
+func (d *differ) dropChangefeeds() {
+	desired := changefeedsByName(d.desired.Changefeeds)
+	for _, cf := range d.current.Changefeeds {
+		if dc, has := desired[cf.Name]; has && equalChangefeeds(&cf, &dc) {
+			continue
+		}
+		d.add(Step{
+			Action:      ActionDropChangefeed,
+			Name:        cf.Name,
+			Destructive: true,
+			Option:      options.WithDropChangefeed(cf.Name),
+			YQL:         d.alterTable("DROP CHANGEFEED " + quote(cf.Name)),
+		})
+	}
+}
+
+func (d *differ) addChangefeeds() {
+	current := changefeedsByName(d.current.Changefeeds)
+	for _, cf := range d.desired.Changefeeds {
+		if cc, has := current[cf.Name]; has && equalChangefeeds(&cc, &cf) {
+			continue
+		}
+		step := Step{
+			Action: ActionAddChangefeed,
+			Name:   cf.Name,
+			Option: options.WithAddChangefeed(cf.Name, cf.Mode, cf.Format, changefeedOptions(&cf)...),
+		}
+		if settings, ok := changefeedSettings(&cf); ok {
+			step.YQL = d.alterTable("ADD CHANGEFEED " + quote(cf.Name) + " WITH (" + settings + ")")
+		}
+		d.add(step)
+	}
+}
+
+func columnsByName(columns []options.Column) map[string]options.Column {
+	m := make(map[string]options.Column, len(columns))
+	for _, c := range columns {
+		m[c.Name] = c
+	}
+
+	return m
+}
+
+func indexesByName(indexes []options.IndexDescription) map[string]options.IndexDescription {
+	m := make(map[string]options.IndexDescription, len(indexes))
+	for _, idx := range indexes {
+		m[idx.Name] = idx
+	}
+
+	return m
+}
+
+func changefeedsByName(changefeeds []options.ChangefeedDescription) map[string]options.ChangefeedDescription {
+	m := make(map[string]options.ChangefeedDescription, len(changefeeds))
+	for _, cf := range changefeeds {
+		m[cf.Name] = cf
+	}
+
+	return m
+}
+
+func equalStrings(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalIndexes(lhs, rhs *options.IndexDescription) bool {
+	return lhs.Type == rhs.Type &&
+		equalStrings(lhs.IndexColumns, rhs.IndexColumns) &&
+		equalStrings(lhs.DataColumns, rhs.DataColumns)
+}
+
+// equalChangefeeds compares described settings of changefeeds. Settings of changefeed creation
+// (retention period and initial scan) are not described and not compared
+func equalChangefeeds(current, desired *options.ChangefeedDescription) bool {
+	return current.Mode == desired.Mode &&
+		current.Format == desired.Format &&
+		current.VirtualTimestamps == desired.VirtualTimestamps &&
+		current.ResolvedTimestampsInterval == desired.ResolvedTimestampsInterval &&
+		current.AwsRegion == desired.AwsRegion &&
+		(desired.Attributes == nil || equalAttributes(current.Attributes, desired.Attributes))
+}
+
+func equalAttributes(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for k, v := range lhs {
+		if rv, has := rhs[k]; !has || rv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// changefeedOptions returns options of changefeed creation with settings of changefeed description
+func changefeedOptions(cf *options.ChangefeedDescription) (opts []options.ChangefeedOption) {
+	if cf.VirtualTimestamps {
+		opts = append(opts, options.WithChangefeedVirtualTimestamps())
+	}
+	if cf.ResolvedTimestampsInterval > 0 {
+		opts = append(opts, options.WithChangefeedResolvedTimestamps(cf.ResolvedTimestampsInterval))
+	}
+	if cf.RetentionPeriod > 0 {
+		opts = append(opts, options.WithChangefeedRetentionPeriod(cf.RetentionPeriod))
+	}
+	if cf.InitialScan {
+		opts = append(opts, options.WithChangefeedInitialScan())
+	}
+	if cf.AwsRegion != "" {
+		opts = append(opts, options.WithChangefeedAwsRegion(cf.AwsRegion))
+	}
+	for _, key := range sortedKeys(cf.Attributes) {
+		opts = append(opts, options.WithChangefeedAttribute(key, cf.Attributes[key]))
+	}
+
+	return opts
+}
+
+func equalTimeToLive(lhs, rhs *options.TimeToLiveSettings) bool {
+	return lhs.ColumnName == rhs.ColumnName &&
+		lhs.Mode == rhs.Mode &&
+		lhs.ExpireAfterSeconds == rhs.ExpireAfterSeconds &&
+		(lhs.Mode != options.TimeToLiveModeValueSinceUnixEpoch || timeToLiveUnit(lhs) == timeToLiveUnit(rhs))
+}
+
+func timeToLiveUnit(ttl *options.TimeToLiveSettings) options.TimeToLiveUnit {
+	if ttl.ColumnUnit == nil {
+		return options.TimeToLiveUnitUnspecified
+	}
+
+	return *ttl.ColumnUnit
+}
+
+// changedFamilySettings returns column family with specified in desired and changed settings only.
+// Name of returned column family is empty if nothing changed
+func changedFamilySettings(current, desired *options.ColumnFamily) (changes options.ColumnFamily) {
+	if desired.Data.Media != "" && desired.Data.Media != current.Data.Media {
+		changes.Data = desired.Data
+	}
+	if desired.Compression != options.ColumnFamilyCompressionUnknown && desired.Compression != current.Compression {
+		changes.Compression = desired.Compression
+	}
+	if desired.KeepInMemory != options.FeatureFlag(0) && desired.KeepInMemory != current.KeepInMemory {
+		changes.KeepInMemory = desired.KeepInMemory
+	}
+	if changes != (options.ColumnFamily{}) {
+		changes.Name = desired.Name
+	}
+
+	return changes
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
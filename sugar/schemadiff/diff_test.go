@@ -0,0 +1,265 @@
+package schemadiff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func testDescription() options.Description {
+	return options.Description{
+		Name: "series",
+		Columns: []options.Column{
+			{Name: "id", Type: types.TypeUint64, Family: "default"},
+			{Name: "title", Type: types.Optional(types.TypeText), Family: "default"},
+			{Name: "info", Type: types.Optional(types.TypeText), Family: "default"},
+			{Name: "release_date", Type: types.Optional(types.TypeDate), Family: "default"},
+		},
+		PrimaryKey: []string{"id"},
+		ColumnFamilies: []options.ColumnFamily{
+			{Name: "default", Compression: options.ColumnFamilyCompressionNone},
+		},
+		PartitioningSettings: options.PartitioningSettings{
+			PartitioningBySize: options.FeatureEnabled,
+			PartitionSizeMb:    2048,
+			PartitioningByLoad: options.FeatureDisabled,
+			MinPartitionsCount: 1,
+		},
+		Indexes: []options.IndexDescription{
+			{Name: "title_index", IndexColumns: []string{"title"}},
+		},
+		Attributes: map[string]string{
+			"owner": "team-a",
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("NoChanges", func(t *testing.T) {
+		current := testDescription()
+		desired := testDescription()
+		desired.Attributes = nil
+		desired.PartitioningSettings = options.PartitioningSettings{}
+		plan := Diff("/local/series", current, desired)
+		require.True(t, plan.Empty())
+		require.Equal(t, "/local/series: no changes\n", plan.String())
+	})
+	t.Run("Changes", func(t *testing.T) {
+		desired := testDescription()
+		desired.Columns = []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "title", Type: types.Optional(types.TypeText), Family: "hot"},
+			{Name: "release_date", Type: types.Optional(types.TypeDate)},
+			{Name: "rating", Type: types.Optional(types.TypeUint64)},
+		}
+		desired.ColumnFamilies = []options.ColumnFamily{
+			{Name: "default", Compression: options.ColumnFamilyCompressionLZ4},
+			{Name: "hot", Data: options.StoragePool{Media: "ssd"}},
+		}
+		desired.PartitioningSettings = options.PartitioningSettings{
+			MaxPartitionsCount: 100,
+		}
+		desired.Indexes = []options.IndexDescription{
+			{Name: "title_index", IndexColumns: []string{"title"}, DataColumns: []string{"release_date"}},
+		}
+		desired.TimeToLiveSettings = &options.TimeToLiveSettings{
+			ColumnName:         "release_date",
+			Mode:               options.TimeToLiveModeDateType,
+			ExpireAfterSeconds: 3600,
+		}
+		desired.Attributes = map[string]string{
+			"owner": "team-b",
+		}
+		plan := Diff("/local/series", testDescription(), desired)
+		require.Equal(t, []string{
+			"ALTER TABLE `/local/series` DROP INDEX `title_index`;",
+			"ALTER TABLE `/local/series` ALTER FAMILY `default` SET COMPRESSION \"lz4\";",
+			"ALTER TABLE `/local/series` ADD FAMILY `hot` (DATA = \"ssd\");",
+			"ALTER TABLE `/local/series` ADD COLUMN `rating` Uint64;",
+			"ALTER TABLE `/local/series` ALTER COLUMN `title` SET FAMILY `hot`;",
+			"ALTER TABLE `/local/series` DROP COLUMN `info`;",
+			"ALTER TABLE `/local/series` ADD INDEX `title_index` GLOBAL ON (`title`) COVER (`release_date`);",
+			"ALTER TABLE `/local/series` SET (TTL = Interval(\"PT3600S\") ON `release_date`);",
+			"ALTER TABLE `/local/series` SET (AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 100);",
+		}, yqlOfSteps(plan))
+		require.Equal(t, ""+
+			"/local/series:\n"+
+			"    drop index title_index\n"+
+			"    alter column family default\n"+
+			"    add column family hot\n"+
+			"    add column rating\n"+
+			"    set column family title\n"+
+			"  ! drop column info\n"+
+			"    add index title_index\n"+
+			"    set time to live release_date\n"+
+			"    alter partitioning settings\n"+
+			"    alter attribute owner\n",
+			plan.String(),
+		)
+		require.Len(t, plan.Destructive(), 1)
+		require.Equal(t, ActionDropColumn, plan.Destructive()[0].Action)
+		opts, err := plan.Options()
+		require.NoError(t, err)
+		require.Len(t, opts, len(plan.Steps))
+		// attributes cannot be changed with YQL, so attribute steps are skipped
+		yql, err := plan.YQL()
+		require.NoError(t, err)
+		require.Equal(t, yqlOfSteps(plan), yql)
+		require.Len(t, yql, len(plan.Steps)-1)
+	})
+	t.Run("AddColumnFamilies", func(t *testing.T) {
+		desired := testDescription()
+		desired.ColumnFamilies = []options.ColumnFamily{
+			{Name: "default", Compression: options.ColumnFamilyCompressionNone},
+			{Name: "hot", Data: options.StoragePool{Media: "ssd"}},
+			{Name: "cold", Data: options.StoragePool{Media: "hdd"}},
+		}
+		plan := Diff("/local/series", testDescription(), desired)
+		require.Len(t, plan.Steps, 2)
+		opts, err := plan.Options()
+		require.NoError(t, err)
+		require.Len(t, opts, 1)
+		req := testAlterTableRequest(opts)
+		require.Len(t, req.GetAddColumnFamilies(), 2)
+		require.Equal(t, "hot", req.GetAddColumnFamilies()[0].GetName())
+		require.Equal(t, "cold", req.GetAddColumnFamilies()[1].GetName())
+	})
+	t.Run("ChangefeedSettings", func(t *testing.T) {
+		current := testDescription()
+		current.Changefeeds = []options.ChangefeedDescription{
+			{Name: "updates", Mode: options.ChangefeedModeKeysOnly, Format: options.ChangefeedFormatJSON},
+		}
+		desired := testDescription()
+		desired.Changefeeds = []options.ChangefeedDescription{{
+			Name:                       "updates",
+			Mode:                       options.ChangefeedModeKeysOnly,
+			Format:                     options.ChangefeedFormatJSON,
+			VirtualTimestamps:          true,
+			ResolvedTimestampsInterval: time.Second,
+			RetentionPeriod:            24 * time.Hour,
+			InitialScan:                true,
+		}}
+		plan := Diff("/local/series", current, desired)
+		require.Equal(t, []string{
+			"ALTER TABLE `/local/series` DROP CHANGEFEED `updates`;",
+			"ALTER TABLE `/local/series` ADD CHANGEFEED `updates` WITH (MODE = 'KEYS_ONLY', FORMAT = 'JSON', " +
+				"VIRTUAL_TIMESTAMPS = TRUE, RESOLVED_TIMESTAMPS = Interval(\"PT1S\"), " +
+				"RETENTION_PERIOD = Interval(\"PT86400S\"), INITIAL_SCAN = TRUE);",
+		}, yqlOfSteps(plan))
+		opts, err := plan.Options()
+		require.NoError(t, err)
+		req := testAlterTableRequest(opts)
+		require.Equal(t, []string{"updates"}, req.GetDropChangefeeds())
+		require.Len(t, req.GetAddChangefeeds(), 1)
+		cf := req.GetAddChangefeeds()[0]
+		require.True(t, cf.GetVirtualTimestamps())
+		require.Equal(t, time.Second, cf.GetResolvedTimestampsInterval().AsDuration())
+		require.Equal(t, 24*time.Hour, cf.GetRetentionPeriod().AsDuration())
+		require.True(t, cf.GetInitialScan())
+
+		// settings of changefeed creation are not described, so they are not compared
+		current.Changefeeds[0].VirtualTimestamps = true
+		current.Changefeeds[0].ResolvedTimestampsInterval = time.Second
+		require.True(t, Diff("/local/series", current, desired).Empty())
+
+		desired.Changefeeds[0].Attributes = map[string]string{"owner": "team-b"}
+		plan = Diff("/local/series", current, desired)
+		require.Len(t, plan.Steps, 2)
+		_, err = plan.YQL()
+		require.ErrorIs(t, err, ErrUnsupportedStep)
+		opts, err = plan.Options()
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"owner": "team-b"},
+			testAlterTableRequest(opts).GetAddChangefeeds()[0].GetAttributes(),
+		)
+	})
+	t.Run("PrimaryKeyAndColumnType", func(t *testing.T) {
+		desired := testDescription()
+		desired.PrimaryKey = []string{"id", "title"}
+		desired.Columns[2].Type = types.Optional(types.TypeBytes)
+		plan := Diff("/local/series", testDescription(), desired)
+		require.Len(t, plan.Steps, 2)
+		require.Equal(t, ActionAlterPrimaryKey, plan.Steps[0].Action)
+		require.Equal(t, "(id) -> (id, title)", plan.Steps[0].Name)
+		require.Equal(t, ActionAlterColumnType, plan.Steps[1].Action)
+		require.Equal(t, "info Optional<Utf8> -> Optional<String>", plan.Steps[1].Name)
+		require.Len(t, plan.Destructive(), 2)
+		_, err := plan.Options()
+		require.ErrorIs(t, err, ErrUnsupportedStep)
+		_, err = plan.YQL()
+		require.ErrorIs(t, err, ErrUnsupportedStep)
+	})
+	t.Run("UniqueIndex", func(t *testing.T) {
+		desired := testDescription()
+		desired.Indexes = append(desired.Indexes, options.IndexDescription{
+			Name: "title_unique", IndexColumns: []string{"title"}, Type: options.IndexTypeGlobalUnique,
+		})
+		plan := Diff("/local/series", testDescription(), desired)
+		require.Equal(t, []string{
+			"ALTER TABLE `/local/series` ADD INDEX `title_unique` GLOBAL UNIQUE ON (`title`);",
+		}, yqlOfSteps(plan))
+	})
+	t.Run("TimeToLiveAndChangefeeds", func(t *testing.T) {
+		current := testDescription()
+		current.TimeToLiveSettings = &options.TimeToLiveSettings{
+			ColumnName:         "release_date",
+			Mode:               options.TimeToLiveModeDateType,
+			ExpireAfterSeconds: 3600,
+		}
+		current.Changefeeds = []options.ChangefeedDescription{
+			{Name: "updates", Mode: options.ChangefeedModeKeysOnly, Format: options.ChangefeedFormatJSON},
+		}
+		desired := testDescription()
+		desired.Columns = append(desired.Columns, options.Column{Name: "ts", Type: types.TypeUint64})
+		desired.TimeToLiveSettings = &options.TimeToLiveSettings{
+			ColumnName:         "ts",
+			Mode:               options.TimeToLiveModeValueSinceUnixEpoch,
+			ExpireAfterSeconds: 60,
+		}
+		desired.Changefeeds = []options.ChangefeedDescription{
+			{Name: "updates", Mode: options.ChangefeedModeNewImage, Format: options.ChangefeedFormatJSON},
+		}
+		plan := Diff("/local/series", current, desired)
+		require.Equal(t, []string{
+			"ALTER TABLE `/local/series` DROP CHANGEFEED `updates`;",
+			"ALTER TABLE `/local/series` ADD COLUMN `ts` Uint64 NOT NULL;",
+			"ALTER TABLE `/local/series` SET (TTL = Interval(\"PT60S\") ON `ts` AS SECONDS);",
+			"ALTER TABLE `/local/series` ADD CHANGEFEED `updates` WITH (MODE = 'NEW_IMAGE', FORMAT = 'JSON');",
+		}, yqlOfSteps(plan))
+		require.True(t, plan.Steps[0].Destructive)
+		opts, err := plan.Options()
+		require.NoError(t, err)
+		require.Len(t, opts, 4)
+
+		desired.TimeToLiveSettings = nil
+		plan = Diff("/local/series", current, desired)
+		require.Contains(t, yqlOfSteps(plan), "ALTER TABLE `/local/series` RESET (TTL);")
+	})
+}
+
+func testAlterTableRequest(opts []options.AlterTableOption) *Ydb_Table.AlterTableRequest {
+	a := allocator.New()
+	defer a.Free()
+	req := &Ydb_Table.AlterTableRequest{}
+	for _, opt := range opts {
+		opt.ApplyAlterTableOption((*options.AlterTableDesc)(req), a)
+	}
+
+	return req
+}
+
+func yqlOfSteps(plan *Plan) (statements []string) {
+	for i := range plan.Steps {
+		if plan.Steps[i].YQL != "" {
+			statements = append(statements, plan.Steps[i].YQL)
+		}
+	}
+
+	return statements
+}
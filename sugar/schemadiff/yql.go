@@ -0,0 +1,151 @@
+package schemadiff
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const defaultFamily = "default"
+
+func quote(name string) string {
+	return "`" + name + "`"
+}
+
+func quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i := range names {
+		quoted[i] = quote(names[i])
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// columnType returns type of column for ADD COLUMN statement: optional types are
+// nullable columns, other types are NOT NULL columns
+func columnType(t types.Type) string {
+	if optional, ok := t.(types.Optional); ok {
+		return optional.InnerType().Yql()
+	}
+
+	return t.Yql() + " NOT NULL"
+}
+
+func familyName(name string) string {
+	if name == "" {
+		return defaultFamily
+	}
+
+	return name
+}
+
+func indexType(t options.IndexType) string {
+	switch t {
+	case options.IndexTypeGlobalAsync:
+		return "GLOBAL ASYNC"
+	case options.IndexTypeGlobalUnique:
+		return "GLOBAL UNIQUE"
+	default:
+		return "GLOBAL"
+	}
+}
+
+func cover(columns []string) string {
+	if len(columns) == 0 {
+		return ""
+	}
+
+	return " COVER (" + quoteAll(columns) + ")"
+}
+
+// familySettings returns settings of column family in YQL syntax. Returns false if
+// column family has settings which cannot be expressed in YQL
+func familySettings(cf *options.ColumnFamily, separator string) (settings []string, ok bool) {
+	if cf.KeepInMemory != options.FeatureFlag(0) {
+		return nil, false
+	}
+	if cf.Data.Media != "" {
+		settings = append(settings, "DATA"+separator+strconv.Quote(cf.Data.Media))
+	}
+	if cf.Compression != options.ColumnFamilyCompressionUnknown {
+		settings = append(settings, "COMPRESSION"+separator+strconv.Quote(cf.Compression.String()))
+	}
+
+	return settings, len(settings) > 0
+}
+
+func timeToLive(ttl *options.TimeToLiveSettings) string {
+	s := "Interval(\"PT" + strconv.FormatUint(uint64(ttl.ExpireAfterSeconds), 10) + "S\") ON " + quote(ttl.ColumnName)
+	if ttl.Mode != options.TimeToLiveModeValueSinceUnixEpoch {
+		return s
+	}
+	switch timeToLiveUnit(ttl) {
+	case options.TimeToLiveUnitMilliseconds:
+		return s + " AS MILLISECONDS"
+	case options.TimeToLiveUnitMicroseconds:
+		return s + " AS MICROSECONDS"
+	case options.TimeToLiveUnitNanoseconds:
+		return s + " AS NANOSECONDS"
+	default:
+		return s + " AS SECONDS"
+	}
+}
+
+func featureFlag(f options.FeatureFlag) string {
+	if f == options.FeatureEnabled {
+		return "ENABLED"
+	}
+
+	return "DISABLED"
+}
+
+func interval(d time.Duration) string {
+	return "Interval(\"PT" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S\")"
+}
+
+// changefeedSettings returns settings of changefeed in YQL syntax. Returns false if
+// changefeed has settings which cannot be expressed in YQL
+func changefeedSettings(cf *options.ChangefeedDescription) (_ string, ok bool) {
+	if len(cf.Attributes) > 0 {
+		return "", false
+	}
+	settings := make([]string, 0, 2)
+	switch cf.Mode {
+	case options.ChangefeedModeKeysOnly:
+		settings = append(settings, "MODE = 'KEYS_ONLY'")
+	case options.ChangefeedModeUpdates:
+		settings = append(settings, "MODE = 'UPDATES'")
+	case options.ChangefeedModeNewImage:
+		settings = append(settings, "MODE = 'NEW_IMAGE'")
+	case options.ChangefeedModeOldImage:
+		settings = append(settings, "MODE = 'OLD_IMAGE'")
+	case options.ChangefeedModeNewAndOldImages:
+		settings = append(settings, "MODE = 'NEW_AND_OLD_IMAGES'")
+	}
+	switch cf.Format {
+	case options.ChangefeedFormatJSON:
+		settings = append(settings, "FORMAT = 'JSON'")
+	case options.ChangefeedFormatDynamoDBStreamsJSON:
+		settings = append(settings, "FORMAT = 'DYNAMODB_STREAMS_JSON'")
+	}
+	if cf.VirtualTimestamps {
+		settings = append(settings, "VIRTUAL_TIMESTAMPS = TRUE")
+	}
+	if cf.ResolvedTimestampsInterval > 0 {
+		settings = append(settings, "RESOLVED_TIMESTAMPS = "+interval(cf.ResolvedTimestampsInterval))
+	}
+	if cf.RetentionPeriod > 0 {
+		settings = append(settings, "RETENTION_PERIOD = "+interval(cf.RetentionPeriod))
+	}
+	if cf.InitialScan {
+		settings = append(settings, "INITIAL_SCAN = TRUE")
+	}
+	if cf.AwsRegion != "" {
+		settings = append(settings, "AWS_REGION = "+strconv.Quote(cf.AwsRegion))
+	}
+
+	return strings.Join(settings, ", "), true
+}
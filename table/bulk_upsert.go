@@ -0,0 +1,315 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bulkupsert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const (
+	defaultBulkUpsertChunkSize   = 8 << 20
+	defaultBulkUpsertConcurrency = 4
+)
+
+var errBulkUpsertNoRows = errors.New("no rows for bulk upsert")
+
+type bulkUpsertStructs[T any] struct {
+	rows    []T
+	columns []options.Column
+}
+
+func (data bulkUpsertStructs[T]) ToYDB(a *allocator.Allocator, tableName string) (*Ydb_Table.BulkUpsertRequest, error) {
+	if len(data.rows) == 0 {
+		return nil, xerrors.WithStackTrace(errBulkUpsertNoRows)
+	}
+
+	encoder, err := newBulkUpsertEncoder[T](data.columns)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	items := make([]value.Value, 0, len(data.rows))
+	for i := range data.rows {
+		item, _, err := encoder.Encode(reflect.ValueOf(&data.rows[i]))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("row %d: %w", i, err))
+		}
+		items = append(items, item)
+	}
+
+	return &Ydb_Table.BulkUpsertRequest{
+		Table: tableName,
+		Rows:  value.ToYDB(value.ListValue(items...), a),
+	}, nil
+}
+
+// BulkUpsertDataStructs makes BulkUpsertData from rows of structs.
+// Exported fields of struct mapped to columns by tag "sql" (field name by default), fields with tag "-" are skipped.
+// Fields converted to types of columns (usually columns of table from DescribeTable). If columns not
+// defined types of columns are inferred from types of struct fields
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertDataStructs[T any](rows []T, columns ...options.Column) bulkUpsertStructs[T] {
+	return bulkUpsertStructs[T]{
+		rows:    rows,
+		columns: columns,
+	}
+}
+
+// BulkUpsertChunk describes result of upsert of rows chunk
+type BulkUpsertChunk struct {
+	// Index is a sequence number of chunk
+	Index int
+
+	// Offset is an index of first row of chunk
+	Offset int
+
+	// Rows is a count of rows in chunk
+	Rows int
+
+	// Size is an approximate size of chunk data in bytes
+	Size int
+
+	// Err is an error of chunk upsert
+	Err error
+}
+
+type (
+	bulkUpsertStructsConfig struct {
+		columns     []options.Column
+		chunkSize   int
+		chunkRows   int
+		concurrency int
+		onChunk     func(chunk BulkUpsertChunk)
+		opts        []Option
+	}
+	BulkUpsertStructsOption func(c *bulkUpsertStructsConfig)
+)
+
+// WithBulkUpsertColumns sets columns of table for BulkUpsertStructs instead of DescribeTable call
+func WithBulkUpsertColumns(columns ...options.Column) BulkUpsertStructsOption {
+	return func(c *bulkUpsertStructsConfig) {
+		c.columns = append(c.columns, columns...)
+	}
+}
+
+// WithBulkUpsertChunkSize sets max approximate size of chunk data in bytes. Default is 8MB
+func WithBulkUpsertChunkSize(size int) BulkUpsertStructsOption {
+	return func(c *bulkUpsertStructsConfig) {
+		if size > 0 {
+			c.chunkSize = size
+		}
+	}
+}
+
+// WithBulkUpsertChunkRows sets max count of rows in chunk. Default is unlimited
+func WithBulkUpsertChunkRows(rows int) BulkUpsertStructsOption {
+	return func(c *bulkUpsertStructsConfig) {
+		if rows > 0 {
+			c.chunkRows = rows
+		}
+	}
+}
+
+// WithBulkUpsertConcurrency sets max count of concurrently upserted chunks. Default is 4
+func WithBulkUpsertConcurrency(concurrency int) BulkUpsertStructsOption {
+	return func(c *bulkUpsertStructsConfig) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// WithBulkUpsertOnChunk sets callback which called on upsert of each chunk (successful or not).
+// Callback calls are serialized
+func WithBulkUpsertOnChunk(onChunk func(chunk BulkUpsertChunk)) BulkUpsertStructsOption {
+	return func(c *bulkUpsertStructsConfig) {
+		c.onChunk = onChunk
+	}
+}
+
+// WithBulkUpsertOptions sets options of BulkUpsert call for each chunk
+func WithBulkUpsertOptions(opts ...Option) BulkUpsertStructsOption {
+	return func(c *bulkUpsertStructsConfig) {
+		c.opts = append(c.opts, opts...)
+	}
+}
+
+// BulkUpsertStructs upserts rows of structs into table. BulkUpsertStructs converts fields of structs to
+// types of table columns (see BulkUpsertDataStructs), splits rows into chunks of bounded size and
+// upserts chunks concurrently with retries.
+// Chunks upserts non-transactionally, so on error some chunks may be already upserted.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertStructs[T any](
+	ctx context.Context, c Client, tableName string, rows []T, opts ...BulkUpsertStructsOption,
+) error {
+	cfg := bulkUpsertStructsConfig{
+		chunkSize:   defaultBulkUpsertChunkSize,
+		concurrency: defaultBulkUpsertConcurrency,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if len(cfg.columns) == 0 {
+		err := c.Do(ctx, func(ctx context.Context, s Session) error {
+			desc, err := s.DescribeTable(ctx, tableName)
+			if err != nil {
+				return err
+			}
+			cfg.columns = desc.Columns
+
+			return nil
+		}, WithIdempotent())
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	encoder, err := newBulkUpsertEncoder[T](cfg.columns)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var (
+		g, groupCtx = errgroup.WithContext(ctx)
+		mu          sync.Mutex
+		chunk       = BulkUpsertChunk{}
+		items       []value.Value
+	)
+	g.SetLimit(cfg.concurrency)
+
+	flush := func() {
+		chunk, items := chunk, items
+		g.Go(func() error {
+			err := c.BulkUpsert(groupCtx, tableName, BulkUpsertDataRows(value.ListValue(items...)), cfg.opts...)
+			if err != nil {
+				err = xerrors.WithStackTrace(fmt.Errorf("bulk upsert of rows [%d, %d) failed: %w",
+					chunk.Offset, chunk.Offset+chunk.Rows, err,
+				))
+			}
+			if cfg.onChunk != nil {
+				chunk.Err = err
+				mu.Lock()
+				defer mu.Unlock()
+				cfg.onChunk(chunk)
+			}
+
+			return err
+		})
+	}
+
+	for i := range rows {
+		if groupCtx.Err() != nil {
+			break
+		}
+
+		item, size, err := encoder.Encode(reflect.ValueOf(&rows[i]))
+		if err != nil {
+			_ = g.Wait()
+
+			return xerrors.WithStackTrace(fmt.Errorf("row %d: %w", i, err))
+		}
+
+		full := chunk.Size+size > cfg.chunkSize || (cfg.chunkRows > 0 && chunk.Rows == cfg.chunkRows)
+		if chunk.Rows > 0 && full {
+			flush()
+			chunk = BulkUpsertChunk{
+				Index:  chunk.Index + 1,
+				Offset: i,
+			}
+			items = nil
+		}
+
+		items = append(items, item)
+		chunk.Rows++
+		chunk.Size += size
+	}
+
+	if groupCtx.Err() == nil {
+		flush()
+	}
+
+	if err := g.Wait(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func newBulkUpsertEncoder[T any](columns []options.Column) (*bulkupsert.Encoder, error) {
+	encoderColumns := make([]bulkupsert.Column, 0, len(columns))
+	for i := range columns {
+		encoderColumns = append(encoderColumns, bulkupsert.Column{
+			Name: columns[i].Name,
+			Type: columns[i].Type,
+		})
+	}
+
+	return bulkupsert.NewEncoder(reflect.TypeOf((*T)(nil)).Elem(), encoderColumns)
+}
+
+// ArrowRecordBatch encodes rows (struct values with the same fields) to Arrow IPC schema message and
+// Arrow IPC record batch message, so rows can be upserted with BulkUpsertDataArrow without Arrow library:
+//
+//	schema, batch, err := table.ArrowRecordBatch(rows)
+//	...
+//	err = db.Table().BulkUpsert(ctx, tableName, table.BulkUpsertDataArrow(batch, table.WithArrowSchema(schema)))
+//
+// Supported types of columns are Bool, Int8-Int64, Uint8-Uint64, Float, Double, Date, Datetime, Timestamp,
+// Interval, Text, JSON, Bytes, YSON and optionals of them
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ArrowRecordBatch(rows []value.Value) (schema, batch []byte, _ error) {
+	schema, batch, err := bulkupsert.EncodeArrow(rows)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	return schema, batch, nil
+}
+
+type bulkUpsertArrowRows struct {
+	rows []value.Value
+}
+
+func (data bulkUpsertArrowRows) ToYDB(a *allocator.Allocator, tableName string) (*Ydb_Table.BulkUpsertRequest, error) {
+	schema, batch, err := ArrowRecordBatch(data.rows)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return BulkUpsertDataArrow(batch, WithArrowSchema(schema)).ToYDB(a, tableName)
+}
+
+// BulkUpsertDataArrowRows makes BulkUpsertData which sends rows (struct values with the same fields) in
+// Arrow format instead of ydb values tree (see ArrowRecordBatch)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertDataArrowRows(rows []value.Value) bulkUpsertArrowRows {
+	return bulkUpsertArrowRows{
+		rows: rows,
+	}
+}
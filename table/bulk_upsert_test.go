@@ -0,0 +1,233 @@
+package table_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	internalTypes "github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	testBulkUpsertRow struct {
+		ID       int    `sql:"id"`
+		Title    string `sql:"title"`
+		Rating   *int   `sql:"rating"`
+		Internal string `sql:"-"`
+	}
+	testBulkUpsertClient struct {
+		table.Client
+
+		columns []options.Column
+		fail    map[uint64]error // first id of chunk -> error
+
+		mu     sync.Mutex
+		chunks [][]uint64
+	}
+	testBulkUpsertSession struct {
+		table.Session
+
+		columns []options.Column
+	}
+)
+
+func (s testBulkUpsertSession) DescribeTable(
+	ctx context.Context, path string, opts ...options.DescribeTableOption,
+) (options.Description, error) {
+	return options.Description{Columns: s.columns}, nil
+}
+
+func (c *testBulkUpsertClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return op(ctx, testBulkUpsertSession{columns: c.columns})
+}
+
+func (c *testBulkUpsertClient) BulkUpsert(
+	ctx context.Context, tableName string, data table.BulkUpsertData, opts ...table.Option,
+) error {
+	a := allocator.New()
+	defer a.Free()
+
+	request, err := data.ToYDB(a, tableName)
+	if err != nil {
+		return err
+	}
+
+	var ids []uint64
+	for _, row := range request.GetRows().GetValue().GetItems() {
+		ids = append(ids, row.GetItems()[0].GetUint64Value())
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.chunks = append(c.chunks, ids)
+
+	return c.fail[ids[0]]
+}
+
+func testBulkUpsertColumns() []options.Column {
+	return []options.Column{
+		{Name: "id", Type: types.TypeUint64},
+		{Name: "title", Type: types.Optional(types.TypeText)},
+		{Name: "rating", Type: types.Optional(types.TypeUint32)},
+	}
+}
+
+func testBulkUpsertRows(n int) []testBulkUpsertRow {
+	rows := make([]testBulkUpsertRow, n)
+	for i := range rows {
+		rows[i] = testBulkUpsertRow{ID: i, Title: "title"}
+	}
+
+	return rows
+}
+
+func TestBulkUpsertDataStructs(t *testing.T) {
+	rating := 5
+	rows := []testBulkUpsertRow{
+		{ID: 1, Title: "first", Rating: &rating, Internal: "skipped"},
+		{ID: 2, Title: "second"},
+	}
+	expected := table.BulkUpsertDataRows(types.ListValue(
+		types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(1)),
+			types.StructFieldValue("title", types.OptionalValue(types.TextValue("first"))),
+			types.StructFieldValue("rating", types.OptionalValue(types.Uint32Value(5))),
+		),
+		types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(2)),
+			types.StructFieldValue("title", types.OptionalValue(types.TextValue("second"))),
+			types.StructFieldValue("rating", types.NullValue(types.TypeUint32)),
+		),
+	))
+
+	a := allocator.New()
+	defer a.Free()
+
+	expectedRequest, err := expected.ToYDB(a, "test")
+	require.NoError(t, err)
+	request, err := table.BulkUpsertDataStructs(rows, testBulkUpsertColumns()...).ToYDB(a, "test")
+	require.NoError(t, err)
+	require.Equal(t, expectedRequest.String(), request.String())
+
+	t.Run("InferredTypes", func(t *testing.T) {
+		request, err := table.BulkUpsertDataStructs(rows).ToYDB(a, "test")
+		require.NoError(t, err)
+		require.Equal(t,
+			"List<Struct<'id':Int32,'rating':Optional<Int32>,'title':Utf8>>",
+			internalTypes.TypeFromYDB(request.GetRows().GetType()).Yql(),
+		)
+	})
+	t.Run("UnknownColumn", func(t *testing.T) {
+		_, err := table.BulkUpsertDataStructs(rows, testBulkUpsertColumns()[:2]...).ToYDB(a, "test")
+		require.Error(t, err)
+	})
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := table.BulkUpsertDataStructs([]testBulkUpsertRow{{ID: -1}}, testBulkUpsertColumns()...).ToYDB(a, "test")
+		require.Error(t, err)
+	})
+	t.Run("NoRows", func(t *testing.T) {
+		_, err := table.BulkUpsertDataStructs([]testBulkUpsertRow{}).ToYDB(a, "test")
+		require.Error(t, err)
+	})
+}
+
+func TestBulkUpsertStructs(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("Chunks", func(t *testing.T) {
+		c := &testBulkUpsertClient{columns: testBulkUpsertColumns()}
+		var (
+			chunks []table.BulkUpsertChunk
+			rows   int
+		)
+		err := table.BulkUpsertStructs(ctx, c, "/local/test", testBulkUpsertRows(25),
+			table.WithBulkUpsertChunkRows(10),
+			table.WithBulkUpsertConcurrency(2),
+			table.WithBulkUpsertOnChunk(func(chunk table.BulkUpsertChunk) {
+				require.NoError(t, chunk.Err)
+				chunks = append(chunks, chunk)
+				rows += chunk.Rows
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 25, rows)
+		require.Len(t, chunks, 3)
+		sort.Slice(c.chunks, func(i, j int) bool {
+			return c.chunks[i][0] < c.chunks[j][0]
+		})
+		require.Len(t, c.chunks, 3)
+		require.Len(t, c.chunks[0], 10)
+		require.Len(t, c.chunks[1], 10)
+		require.Equal(t, []uint64{20, 21, 22, 23, 24}, c.chunks[2])
+	})
+	t.Run("ChunkSize", func(t *testing.T) {
+		c := &testBulkUpsertClient{}
+		err := table.BulkUpsertStructs(ctx, c, "/local/test", testBulkUpsertRows(100),
+			table.WithBulkUpsertColumns(testBulkUpsertColumns()...),
+			table.WithBulkUpsertChunkSize(200),
+		)
+		require.NoError(t, err)
+		require.Greater(t, len(c.chunks), 1)
+		var rows int
+		for _, chunk := range c.chunks {
+			rows += len(chunk)
+		}
+		require.Equal(t, 100, rows)
+	})
+	t.Run("Error", func(t *testing.T) {
+		testErr := errors.New("test")
+		c := &testBulkUpsertClient{
+			columns: testBulkUpsertColumns(),
+			fail:    map[uint64]error{10: testErr},
+		}
+		var failed []table.BulkUpsertChunk
+		err := table.BulkUpsertStructs(ctx, c, "/local/test", testBulkUpsertRows(30),
+			table.WithBulkUpsertChunkRows(10),
+			table.WithBulkUpsertConcurrency(1),
+			table.WithBulkUpsertOnChunk(func(chunk table.BulkUpsertChunk) {
+				if chunk.Err != nil {
+					failed = append(failed, chunk)
+				}
+			}),
+		)
+		require.ErrorIs(t, err, testErr)
+		require.Len(t, failed, 1)
+		require.Equal(t, 1, failed[0].Index)
+		require.Equal(t, 10, failed[0].Offset)
+	})
+}
+
+func TestBulkUpsertDataArrowRows(t *testing.T) {
+	rows := []types.Value{
+		types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(1)),
+			types.StructFieldValue("title", types.OptionalValue(types.TextValue("first"))),
+		),
+		types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(2)),
+			types.StructFieldValue("title", types.NullValue(types.TypeText)),
+		),
+	}
+	schema, batch, err := table.ArrowRecordBatch(rows)
+	require.NoError(t, err)
+
+	a := allocator.New()
+	defer a.Free()
+
+	request, err := table.BulkUpsertDataArrowRows(rows).ToYDB(a, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", request.GetTable())
+	require.Equal(t, batch, request.GetData())
+	require.Equal(t, schema, request.GetArrowBatchSettings().GetSchema())
+
+	_, err = table.BulkUpsertDataArrowRows(nil).ToYDB(a, "test")
+	require.Error(t, err)
+}
@@ -134,6 +134,36 @@ func Example_createTable() {
 	}
 }
 
+func Example_createColumnTable() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			return s.CreateTable(ctx, path.Join(db.Name(), "events"),
+				options.WithColumn("id", types.TypeUint64),
+				options.WithColumn("ts", types.TypeTimestamp),
+				options.WithColumn("payload", types.Optional(types.TypeJSON)),
+				options.WithPrimaryKeyColumn("id", "ts"),
+				options.WithStoreType(options.StoreTypeColumn),
+				options.WithPartitioningSettings(
+					options.WithPartitioningBy([]string{"id"}),
+					options.WithMinPartitionsCount(16),
+				),
+			)
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
 func Example_bulkUpsert() {
 	ctx := context.TODO()
 	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
@@ -59,6 +59,16 @@ type Description struct {
 	TimeToLiveSettings   *TimeToLiveSettings
 	Changefeeds          []ChangefeedDescription
 	Tiering              string
+
+	// StoreType is a type of table storage: row-oriented or column-oriented
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StoreType StoreType
+
+	// PartitionBy is a list of columns which hashes distribute rows of column table between column shards
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PartitionBy []string
 }
 
 type TableStats struct {
@@ -278,6 +288,7 @@ type (
 const (
 	IndexTypeGlobal = IndexType(iota)
 	IndexTypeGlobalAsync
+	IndexTypeGlobalUnique
 )
 
 func (t IndexType) ApplyIndexOption(d *indexDesc) {
@@ -290,6 +301,10 @@ func (t IndexType) ApplyIndexOption(d *indexDesc) {
 		d.Type = &Ydb_Table.TableIndex_GlobalAsyncIndex{
 			GlobalAsyncIndex: &Ydb_Table.GlobalAsyncIndex{},
 		}
+	case IndexTypeGlobalUnique:
+		d.Type = &Ydb_Table.TableIndex_GlobalUniqueIndex{
+			GlobalUniqueIndex: &Ydb_Table.GlobalUniqueIndex{},
+		}
 	}
 }
 
@@ -301,6 +316,13 @@ func GlobalAsyncIndex() IndexType {
 	return IndexTypeGlobalAsync
 }
 
+// GlobalUniqueIndex returns type of synchronous global index with unique constraint on index columns
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func GlobalUniqueIndex() IndexType {
+	return IndexTypeGlobalUnique
+}
+
 type PartitioningMode byte
 
 const (
@@ -561,18 +583,31 @@ func (unit *TimeToLiveUnit) ToYDB() Ydb_Table.ValueSinceUnixEpochModeSettings_Un
 }
 
 type ChangefeedDescription struct {
-	Name   string
-	Mode   ChangefeedMode
-	Format ChangefeedFormat
-	State  ChangefeedState
+	Name                       string
+	Mode                       ChangefeedMode
+	Format                     ChangefeedFormat
+	State                      ChangefeedState
+	VirtualTimestamps          bool
+	ResolvedTimestampsInterval time.Duration
+	AwsRegion                  string
+	Attributes                 map[string]string
+
+	// RetentionPeriod and InitialScan are settings of changefeed creation.
+	// DescribeTable does not return them, they are used by creation of changefeed from description only
+	RetentionPeriod time.Duration
+	InitialScan     bool
 }
 
 func NewChangefeedDescription(proto *Ydb_Table.ChangefeedDescription) ChangefeedDescription {
 	return ChangefeedDescription{
-		Name:   proto.GetName(),
-		Mode:   ChangefeedMode(proto.GetMode()),
-		Format: ChangefeedFormat(proto.GetFormat()),
-		State:  ChangefeedState(proto.GetState()),
+		Name:                       proto.GetName(),
+		Mode:                       ChangefeedMode(proto.GetMode()),
+		Format:                     ChangefeedFormat(proto.GetFormat()),
+		State:                      ChangefeedState(proto.GetState()),
+		VirtualTimestamps:          proto.GetVirtualTimestamps(),
+		ResolvedTimestampsInterval: proto.GetResolvedTimestampsInterval().AsDuration(),
+		AwsRegion:                  proto.GetAwsRegion(),
+		Attributes:                 proto.GetAttributes(),
 	}
 }
 
@@ -602,3 +637,18 @@ const (
 	ChangefeedFormatJSON                = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_JSON)
 	ChangefeedFormatDynamoDBStreamsJSON = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_DYNAMODB_STREAMS_JSON)
 )
+
+// StoreType is a type of table storage
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type StoreType int
+
+const (
+	StoreTypeUnspecified = StoreType(Ydb_Table.StoreType_STORE_TYPE_UNSPECIFIED)
+	StoreTypeRow         = StoreType(Ydb_Table.StoreType_STORE_TYPE_ROW)
+	StoreTypeColumn      = StoreType(Ydb_Table.StoreType_STORE_TYPE_COLUMN)
+)
+
+func (t StoreType) ApplyCreateTableOption(d *CreateTableDesc, a *allocator.Allocator) {
+	d.StoreType = Ydb_Table.StoreType(t)
+}
@@ -1,9 +1,13 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
@@ -204,6 +208,149 @@ func WithDropIndex(name string) AlterTableOption {
 	return dropIndex(name)
 }
 
+type (
+	changefeedDesc   Ydb_Table.Changefeed
+	ChangefeedOption interface {
+		ApplyChangefeedOption(d *changefeedDesc)
+	}
+)
+
+type changefeed struct {
+	name   string
+	mode   ChangefeedMode
+	format ChangefeedFormat
+	opts   []ChangefeedOption
+}
+
+func (cf changefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	x := &Ydb_Table.Changefeed{
+		Name:   cf.name,
+		Mode:   Ydb_Table.ChangefeedMode_Mode(cf.mode),
+		Format: Ydb_Table.ChangefeedFormat_Format(cf.format),
+	}
+	for _, opt := range cf.opts {
+		if opt != nil {
+			opt.ApplyChangefeedOption((*changefeedDesc)(x))
+		}
+	}
+	d.AddChangefeeds = append(d.AddChangefeeds, x)
+}
+
+// WithAddChangefeed adds changefeed (CDC stream of table changes) with given mode and format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAddChangefeed(
+	name string, mode ChangefeedMode, format ChangefeedFormat, opts ...ChangefeedOption,
+) AlterTableOption {
+	return changefeed{
+		name:   name,
+		mode:   mode,
+		format: format,
+		opts:   opts,
+	}
+}
+
+type dropChangefeed string
+
+func (cf dropChangefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.DropChangefeeds = append(d.DropChangefeeds, string(cf))
+}
+
+// WithDropChangefeed drops changefeed of table
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDropChangefeed(name string) AlterTableOption {
+	return dropChangefeed(name)
+}
+
+type changefeedRetentionPeriod time.Duration
+
+func (period changefeedRetentionPeriod) ApplyChangefeedOption(d *changefeedDesc) {
+	d.RetentionPeriod = durationpb.New(time.Duration(period))
+}
+
+// WithChangefeedRetentionPeriod sets retention period of changefeed topic data
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedRetentionPeriod(period time.Duration) ChangefeedOption {
+	return changefeedRetentionPeriod(period)
+}
+
+type changefeedVirtualTimestamps bool
+
+func (enabled changefeedVirtualTimestamps) ApplyChangefeedOption(d *changefeedDesc) {
+	d.VirtualTimestamps = bool(enabled)
+}
+
+// WithChangefeedVirtualTimestamps enables virtual timestamps of changefeed records
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedVirtualTimestamps() ChangefeedOption {
+	return changefeedVirtualTimestamps(true)
+}
+
+type changefeedInitialScan bool
+
+func (enabled changefeedInitialScan) ApplyChangefeedOption(d *changefeedDesc) {
+	d.InitialScan = bool(enabled)
+}
+
+// WithChangefeedInitialScan enables initial scan: changefeed starts with current rows of table
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedInitialScan() ChangefeedOption {
+	return changefeedInitialScan(true)
+}
+
+type changefeedResolvedTimestamps time.Duration
+
+func (interval changefeedResolvedTimestamps) ApplyChangefeedOption(d *changefeedDesc) {
+	d.ResolvedTimestampsInterval = durationpb.New(time.Duration(interval))
+}
+
+// WithChangefeedResolvedTimestamps enables resolved timestamps (heartbeat records) of changefeed
+// with given interval
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedResolvedTimestamps(interval time.Duration) ChangefeedOption {
+	return changefeedResolvedTimestamps(interval)
+}
+
+type changefeedAwsRegion string
+
+func (region changefeedAwsRegion) ApplyChangefeedOption(d *changefeedDesc) {
+	d.AwsRegion = string(region)
+}
+
+// WithChangefeedAwsRegion sets value of awsRegion field of records in DYNAMODB_STREAMS_JSON format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedAwsRegion(region string) ChangefeedOption {
+	return changefeedAwsRegion(region)
+}
+
+type changefeedAttribute struct {
+	key   string
+	value string
+}
+
+func (attr changefeedAttribute) ApplyChangefeedOption(d *changefeedDesc) {
+	if d.Attributes == nil {
+		d.Attributes = make(map[string]string)
+	}
+	d.Attributes[attr.key] = attr.value
+}
+
+// WithChangefeedAttribute sets attribute of changefeed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedAttribute(key, value string) ChangefeedOption {
+	return changefeedAttribute{
+		key:   key,
+		value: value,
+	}
+}
+
 type indexColumns []string
 
 func (columns indexColumns) ApplyIndexOption(d *indexDesc) {
@@ -663,6 +810,8 @@ func (columns partitioningByPartitioningSettingsOption) ApplyPartitioningSetting
 	settings.PartitionBy = columns
 }
 
+// WithPartitioningBy sets columns which hashes distribute rows of column table between column shards.
+// Count of column shards defines with WithMinPartitionsCount
 func WithPartitioningBy(columns []string) PartitioningSettingsOption {
 	return partitioningByPartitioningSettingsOption(columns)
 }
@@ -691,6 +840,33 @@ func WithMaxPartitionsCount(maxPartitionsCount uint64) PartitioningSettingsOptio
 	return maxPartitionsCountPartitioningSettingsOption(maxPartitionsCount)
 }
 
+// WithStoreType sets type of table storage in CreateTable request.
+// Column-oriented tables (StoreTypeColumn) are partitioned by hash of columns (see WithPartitioningBy)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStoreType(t StoreType) CreateTableOption {
+	return t
+}
+
+type tiering string
+
+func (t tiering) ApplyCreateTableOption(d *CreateTableDesc, a *allocator.Allocator) {
+	d.Tiering = string(t)
+}
+
+func (t tiering) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.TieringAction = &Ydb_Table.AlterTableRequest_SetTiering{
+		SetTiering: string(t),
+	}
+}
+
+// WithTiering sets tiering rules of column table in CreateTable request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTiering(name string) CreateTableOption {
+	return tiering(name)
+}
+
 type (
 	DropTableDesc   Ydb_Table.DropTableRequest
 	DropTableOption interface {
@@ -758,6 +934,44 @@ func WithAlterColumnFamilies(cf ...ColumnFamily) AlterTableOption {
 	return columnFamilies(cf)
 }
 
+type alterColumnFamilies []ColumnFamily
+
+func (cf alterColumnFamilies) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	for i := range cf {
+		d.AlterColumnFamilies = append(d.AlterColumnFamilies, cf[i].toYDB())
+	}
+}
+
+// WithAlterColumnFamilySettings changes settings of existing column families in AlterTable request.
+// Unspecified settings of column family are not changed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAlterColumnFamilySettings(cf ...ColumnFamily) AlterTableOption {
+	return alterColumnFamilies(cf)
+}
+
+type setColumnFamily struct {
+	column string
+	family string
+}
+
+func (c setColumnFamily) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.AlterColumns = append(d.AlterColumns, &Ydb_Table.ColumnMeta{
+		Name:   c.column,
+		Family: c.family,
+	})
+}
+
+// WithSetColumnFamily moves existing column to column family in AlterTable request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSetColumnFamily(column, family string) AlterTableOption {
+	return setColumnFamily{
+		column: column,
+		family: family,
+	}
+}
+
 func WithAlterReadReplicasSettings(rr ReadReplicasSettings) AlterTableOption {
 	return readReplicasSettings(rr)
 }
@@ -790,6 +1004,28 @@ func WithDropTimeToLive() AlterTableOption {
 	return dropTimeToLive{}
 }
 
+// WithSetTiering sets tiering rules of column table in AlterTable request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSetTiering(name string) AlterTableOption {
+	return tiering(name)
+}
+
+type dropTiering struct{}
+
+func (dropTiering) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.TieringAction = &Ydb_Table.AlterTableRequest_DropTiering{
+		DropTiering: &emptypb.Empty{},
+	}
+}
+
+// WithDropTiering drops tiering rules of column table in AlterTable request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDropTiering() AlterTableOption {
+	return dropTiering{}
+}
+
 type (
 	CopyTableDesc   Ydb_Table.CopyTableRequest
 	CopyTableOption func(*CopyTableDesc)
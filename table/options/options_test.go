@@ -2,6 +2,7 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -223,6 +224,42 @@ func TestAlterTableOptions(t *testing.T) {
 			t.Errorf("Alter table options is not as expected")
 		}
 	}
+	{
+		req := Ydb_Table.AlterTableRequest{}
+		WithAddColumnFamilies(ColumnFamily{Name: "a"}, ColumnFamily{Name: "b"}).
+			ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		if len(req.GetAddColumnFamilies()) != 2 ||
+			req.GetAddColumnFamilies()[0].GetName() != "a" ||
+			req.GetAddColumnFamilies()[1].GetName() != "b" {
+			t.Errorf("Alter table options is not as expected")
+		}
+	}
+	{
+		cf := ColumnFamily{
+			Name:        "default",
+			Compression: ColumnFamilyCompressionLZ4,
+		}
+		opt := WithAlterColumnFamilySettings(cf)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		if len(req.GetAddColumnFamilies()) != 0 ||
+			len(req.GetAlterColumnFamilies()) != 1 ||
+			req.GetAlterColumnFamilies()[0].GetName() != cf.Name ||
+			req.GetAlterColumnFamilies()[0].GetCompression() != cf.Compression.toYDB() {
+			t.Errorf("Alter table options is not as expected")
+		}
+	}
+	{
+		opt := WithSetColumnFamily("a", "b")
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		if len(req.GetAlterColumns()) != 1 ||
+			req.GetAlterColumns()[0].GetName() != "a" ||
+			req.GetAlterColumns()[0].GetFamily() != "b" ||
+			req.GetAlterColumns()[0].GetType() != nil {
+			t.Errorf("Alter table options is not as expected")
+		}
+	}
 	{
 		rr := ReadReplicasSettings{
 			Type:  ReadReplicasAnyAzReadReplicas,
@@ -252,3 +289,108 @@ func TestAlterTableOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestAlterTableChangefeedOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	req := Ydb_Table.AlterTableRequest{}
+	for _, opt := range []AlterTableOption{
+		WithAddChangefeed("updates", ChangefeedModeNewAndOldImages, ChangefeedFormatJSON,
+			WithChangefeedRetentionPeriod(24*time.Hour),
+			WithChangefeedVirtualTimestamps(),
+			WithChangefeedInitialScan(),
+			WithChangefeedResolvedTimestamps(time.Second),
+			WithChangefeedAttribute("k", "v"),
+		),
+		WithAddChangefeed("keys", ChangefeedModeKeysOnly, ChangefeedFormatDynamoDBStreamsJSON),
+		WithDropChangefeed("old"),
+	} {
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+	}
+
+	require.Len(t, req.GetAddChangefeeds(), 2)
+	cf := req.GetAddChangefeeds()[0]
+	require.Equal(t, "updates", cf.GetName())
+	require.Equal(t, Ydb_Table.ChangefeedMode_MODE_NEW_AND_OLD_IMAGES, cf.GetMode())
+	require.Equal(t, Ydb_Table.ChangefeedFormat_FORMAT_JSON, cf.GetFormat())
+	require.Equal(t, 24*time.Hour, cf.GetRetentionPeriod().AsDuration())
+	require.True(t, cf.GetVirtualTimestamps())
+	require.True(t, cf.GetInitialScan())
+	require.Equal(t, time.Second, cf.GetResolvedTimestampsInterval().AsDuration())
+	require.Equal(t, map[string]string{"k": "v"}, cf.GetAttributes())
+
+	cf = req.GetAddChangefeeds()[1]
+	require.Equal(t, "keys", cf.GetName())
+	require.Equal(t, Ydb_Table.ChangefeedMode_MODE_KEYS_ONLY, cf.GetMode())
+	require.Equal(t, Ydb_Table.ChangefeedFormat_FORMAT_DYNAMODB_STREAMS_JSON, cf.GetFormat())
+	require.Nil(t, cf.GetRetentionPeriod())
+	require.False(t, cf.GetVirtualTimestamps())
+	require.False(t, cf.GetInitialScan())
+
+	require.Equal(t, []string{"old"}, req.GetDropChangefeeds())
+}
+
+func TestColumnTableOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	createReq := Ydb_Table.CreateTableRequest{}
+	for _, opt := range []CreateTableOption{
+		WithStoreType(StoreTypeColumn),
+		WithPartitioningSettings(
+			WithPartitioningBy([]string{"id"}),
+			WithMinPartitionsCount(16),
+		),
+		WithTiering("tiering_rules"),
+	} {
+		opt.ApplyCreateTableOption((*CreateTableDesc)(&createReq), a)
+	}
+	require.Equal(t, Ydb_Table.StoreType_STORE_TYPE_COLUMN, createReq.GetStoreType())
+	require.Equal(t, []string{"id"}, createReq.GetPartitioningSettings().GetPartitionBy())
+	require.Equal(t, uint64(16), createReq.GetPartitioningSettings().GetMinPartitionsCount())
+	require.Equal(t, "tiering_rules", createReq.GetTiering())
+
+	alterReq := Ydb_Table.AlterTableRequest{}
+	WithSetTiering("tiering_rules").ApplyAlterTableOption((*AlterTableDesc)(&alterReq), a)
+	require.Equal(t, "tiering_rules", alterReq.GetSetTiering())
+	WithDropTiering().ApplyAlterTableOption((*AlterTableDesc)(&alterReq), a)
+	require.NotNil(t, alterReq.GetDropTiering())
+	require.Empty(t, alterReq.GetSetTiering())
+}
+
+func TestIndexTypeOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	for _, tt := range []struct {
+		indexType IndexType
+		check     func(t *testing.T, index *Ydb_Table.TableIndex)
+	}{
+		{
+			indexType: GlobalIndex(),
+			check: func(t *testing.T, index *Ydb_Table.TableIndex) {
+				require.NotNil(t, index.GetGlobalIndex())
+			},
+		},
+		{
+			indexType: GlobalAsyncIndex(),
+			check: func(t *testing.T, index *Ydb_Table.TableIndex) {
+				require.NotNil(t, index.GetGlobalAsyncIndex())
+			},
+		},
+		{
+			indexType: GlobalUniqueIndex(),
+			check: func(t *testing.T, index *Ydb_Table.TableIndex) {
+				require.NotNil(t, index.GetGlobalUniqueIndex())
+			},
+		},
+	} {
+		req := Ydb_Table.AlterTableRequest{}
+		WithAddIndex("idx", WithIndexColumns("a"), WithIndexType(tt.indexType)).
+			ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Len(t, req.GetAddIndexes(), 1)
+		require.Equal(t, []string{"a"}, req.GetAddIndexes()[0].GetIndexColumns())
+		tt.check(t, req.GetAddIndexes()[0])
+	}
+}
@@ -0,0 +1,343 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bulkupsert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+	tableScanner "github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+const (
+	defaultReadRowsBatchSize   = 1000
+	defaultReadRowsConcurrency = 4
+)
+
+var (
+	errReadRowsCompositeKey = errors.New("scalar keys require table with single primary key column")
+	errReadRowsNoKeyColumn  = errors.New("primary key column not found in result set")
+	errReadRowsKeyColumns   = errors.New("fields of key struct mismatch primary key columns")
+)
+
+type (
+	readRowsIntoConfig struct {
+		batchSize   int
+		concurrency int
+		opts        []options.ReadRowsOption
+	}
+	ReadRowsIntoOption func(c *readRowsIntoConfig)
+	readRowsKeyFields  interface {
+		Fields() []value.StructValueField
+	}
+)
+
+// WithReadRowsBatchSize sets max count of keys in one ReadRows request. Default is 1000
+func WithReadRowsBatchSize(size int) ReadRowsIntoOption {
+	return func(c *readRowsIntoConfig) {
+		if size > 0 {
+			c.batchSize = size
+		}
+	}
+}
+
+// WithReadRowsConcurrency sets max count of concurrent ReadRows requests. Default is 4
+func WithReadRowsConcurrency(concurrency int) ReadRowsIntoOption {
+	return func(c *readRowsIntoConfig) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// WithReadRowsOptions sets options of each ReadRows request (for example, options.ReadColumn).
+// Primary key columns are added to read columns automatically, because rows are matched with keys by them
+func WithReadRowsOptions(opts ...options.ReadRowsOption) ReadRowsIntoOption {
+	return func(c *readRowsIntoConfig) {
+		c.opts = append(c.opts, opts...)
+	}
+}
+
+// ReadRowsInto reads rows of table by keys and decodes rows into structs of type T by tag "sql".
+//
+// Keys are structs with fields mapped to primary key columns by tag "sql" (field name by default)
+// or scalar values for tables with single primary key column. Keys are split into batches (see
+// WithReadRowsBatchSize) which read concurrently with retries.
+//
+// Columns of rows without fields in T are skipped.
+// ReadRowsInto returns rows in order of keys and keys which rows not found in table
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadRowsInto[T, K any](
+	ctx context.Context, c Client, path string, keys []K, opts ...ReadRowsIntoOption,
+) (rows []T, missing []K, _ error) {
+	cfg := readRowsIntoConfig{
+		batchSize:   defaultReadRowsBatchSize,
+		concurrency: defaultReadRowsConcurrency,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	var desc options.Description
+	err := c.Do(ctx, func(ctx context.Context, s Session) (err error) {
+		desc, err = s.DescribeTable(ctx, path)
+
+		return err
+	}, WithIdempotent())
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	encode, err := readRowsKeyEncoder[K](&desc)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	readOpts := cfg.opts
+	if columns := readRowsColumns(cfg.opts, desc.PrimaryKey); len(columns) > 0 {
+		readOpts = append(append(make([]options.ReadRowsOption, 0, len(cfg.opts)+1), cfg.opts...),
+			options.ReadColumns(columns...),
+		)
+	}
+
+	var (
+		ids     = make([]string, len(keys))
+		unique  = make(map[string]struct{}, len(keys))
+		batches [][]value.Value
+	)
+	for i := range keys {
+		key, err := encode(reflect.ValueOf(&keys[i]).Elem())
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("key %d: %w", i, err))
+		}
+		ids[i] = readRowsKeyID(key)
+		if _, has := unique[ids[i]]; has {
+			continue
+		}
+		unique[ids[i]] = struct{}{}
+		if len(batches) == 0 || len(batches[len(batches)-1]) == cfg.batchSize {
+			batches = append(batches, make([]value.Value, 0, cfg.batchSize))
+		}
+		batches[len(batches)-1] = append(batches[len(batches)-1], value.StructValue(key...))
+	}
+
+	var (
+		g, groupCtx = errgroup.WithContext(ctx)
+		mu          sync.Mutex
+		found       = make(map[string]T, len(unique))
+	)
+	g.SetLimit(cfg.concurrency)
+	for _, batch := range batches {
+		batch := batch
+		g.Go(func() error {
+			var batchRows map[string]T
+			err := c.Do(groupCtx, func(ctx context.Context, s Session) error {
+				res, err := s.ReadRows(ctx, path, value.ListValue(batch...), readOpts...)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = res.Close()
+				}()
+
+				batchRows, err = readRowsScan[T](ctx, res, desc.PrimaryKey)
+
+				return err
+			}, WithIdempotent())
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for id, row := range batchRows {
+				found[id] = row
+			}
+
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	rows = make([]T, 0, len(found))
+	for i := range keys {
+		if row, has := found[ids[i]]; has {
+			rows = append(rows, row)
+		} else {
+			missing = append(missing, keys[i])
+		}
+	}
+
+	return rows, missing, nil
+}
+
+// readRowsColumns returns primary key columns which are not requested by read columns options.
+// It returns nil if read columns not specified (all columns are read)
+func readRowsColumns(opts []options.ReadRowsOption, primaryKey []string) (missing []string) {
+	desc := options.ReadRowsDesc{}
+	a := allocator.New()
+	defer a.Free()
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyReadRowsOption(&desc, a)
+		}
+	}
+	if len(desc.Columns) == 0 {
+		return nil
+	}
+
+	has := make(map[string]struct{}, len(desc.Columns))
+	for _, column := range desc.Columns {
+		has[column] = struct{}{}
+	}
+	for _, name := range primaryKey {
+		if _, ok := has[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
+
+// readRowsKeyEncoder returns function which converts key to primary key fields
+func readRowsKeyEncoder[K any](
+	desc *options.Description,
+) (func(v reflect.Value) ([]value.StructValueField, error), error) {
+	columns := make([]bulkupsert.Column, 0, len(desc.PrimaryKey))
+	for _, name := range desc.PrimaryKey {
+		for i := range desc.Columns {
+			if desc.Columns[i].Name == name {
+				columns = append(columns, bulkupsert.Column{
+					Name: name,
+					Type: desc.Columns[i].Type,
+				})
+			}
+		}
+	}
+
+	t := reflect.TypeOf((*K)(nil)).Elem()
+	if t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{}) {
+		encoder, err := bulkupsert.NewEncoder(t, columns)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if len(encoder.Columns()) != len(columns) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errReadRowsKeyColumns, desc.PrimaryKey))
+		}
+
+		return func(v reflect.Value) ([]value.StructValueField, error) {
+			key, _, err := encoder.Encode(v)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return key.(readRowsKeyFields).Fields(), nil //nolint:forcetypeassert
+		}, nil
+	}
+
+	if len(columns) != 1 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errReadRowsCompositeKey, desc.PrimaryKey))
+	}
+
+	return func(v reflect.Value) ([]value.StructValueField, error) {
+		key, err := bulkupsert.EncodeValue(v, columns[0].Type)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return []value.StructValueField{{Name: columns[0].Name, V: key}}, nil
+	}, nil
+}
+
+// readRowsKeyID returns identity of key for matching of keys and read rows
+func readRowsKeyID(fields []value.StructValueField) string {
+	fields = append([]value.StructValueField(nil), fields...)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+
+	var id strings.Builder
+	for i := range fields {
+		id.WriteString(fields[i].Name)
+		id.WriteByte('=')
+		if v := value.Unwrap(fields[i].V); v != nil {
+			id.WriteString(v.Yql())
+		} else {
+			id.WriteString("NULL")
+		}
+		id.WriteByte(';')
+	}
+
+	return id.String()
+}
+
+// readRowsScan decodes rows of ReadRows result by identities of primary keys
+func readRowsScan[T any](ctx context.Context, res result.Result, primaryKey []string) (map[string]T, error) {
+	rows := make(map[string]T)
+	for res.NextResultSet(ctx) {
+		columns, items, err := tableScanner.RawRows(res)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		key := make([]int, len(primaryKey))
+		for i, name := range primaryKey {
+			key[i] = -1
+			for j := range columns {
+				if columns[j].GetName() == name {
+					key[i] = j
+
+					break
+				}
+			}
+			if key[i] < 0 {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errReadRowsNoKeyColumn, name))
+			}
+		}
+
+		for _, item := range items {
+			var row T
+			if err := scanner.Struct(scanner.Data(columns, item.GetItems())).ScanStruct(&row,
+				scanner.WithAllowMissingFieldsInStruct(),
+			); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			fields := make([]value.StructValueField, len(key))
+			for i, idx := range key {
+				fields[i] = value.StructValueField{
+					Name: primaryKey[i],
+					V:    value.FromYDB(columns[idx].GetType(), item.GetItems()[idx]),
+				}
+			}
+			rows[readRowsKeyID(fields)] = row
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,260 @@
+package table_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	testReadRowsClient struct {
+		table.Client
+
+		desc options.Description
+		rows []value.Value // struct values with the same fields
+
+		mu      sync.Mutex
+		batches []int
+	}
+	testReadRowsSession struct {
+		table.Session
+
+		c *testReadRowsClient
+	}
+)
+
+func (c *testReadRowsClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return op(ctx, testReadRowsSession{c: c})
+}
+
+func (s testReadRowsSession) DescribeTable(
+	ctx context.Context, path string, opts ...options.DescribeTableOption,
+) (options.Description, error) {
+	return s.c.desc, nil
+}
+
+func (s testReadRowsSession) ReadRows(
+	ctx context.Context, path string, keys value.Value, opts ...options.ReadRowsOption,
+) (result.Result, error) {
+	// allocator not freed because result set refers to allocated values
+	a := allocator.New()
+
+	var (
+		keyType = value.ToYDB(keys, a).GetType().GetListType().GetItem().GetStructType()
+		set     = &Ydb.ResultSet{}
+	)
+	for _, member := range value.ToYDB(s.c.rows[0], a).GetType().GetStructType().GetMembers() {
+		set.Columns = append(set.Columns, &Ydb.Column{
+			Name: member.GetName(),
+			Type: member.GetType(),
+		})
+	}
+	for _, key := range value.ToYDB(keys, a).GetValue().GetItems() {
+		for _, row := range s.c.rows {
+			row := value.ToYDB(row, a).GetValue()
+			if testReadRowsMatch(set.GetColumns(), row, keyType, key) {
+				set.Rows = append(set.Rows, row)
+			}
+		}
+	}
+
+	s.c.mu.Lock()
+	s.c.batches = append(s.c.batches, len(value.ToYDB(keys, a).GetValue().GetItems()))
+	s.c.mu.Unlock()
+
+	desc := options.ReadRowsDesc{}
+	for _, opt := range opts {
+		opt.ApplyReadRowsOption(&desc, a)
+	}
+	if len(desc.Columns) > 0 {
+		return scanner.NewUnary([]*Ydb.ResultSet{testReadRowsProject(set, desc.Columns)}, nil), nil
+	}
+
+	return scanner.NewUnary([]*Ydb.ResultSet{set}, nil), nil
+}
+
+func testReadRowsProject(set *Ydb.ResultSet, names []string) *Ydb.ResultSet {
+	projected := &Ydb.ResultSet{Rows: make([]*Ydb.Value, len(set.GetRows()))}
+	for i := range projected.GetRows() {
+		projected.Rows[i] = &Ydb.Value{}
+	}
+	for _, name := range names {
+		for j, column := range set.GetColumns() {
+			if column.GetName() != name {
+				continue
+			}
+			projected.Columns = append(projected.Columns, column)
+			for i, row := range set.GetRows() {
+				projected.Rows[i].Items = append(projected.Rows[i].Items, row.GetItems()[j])
+			}
+		}
+	}
+
+	return projected
+}
+
+func testReadRowsMatch(columns []*Ydb.Column, row *Ydb.Value, keyType *Ydb.StructType, key *Ydb.Value) bool {
+	for i, member := range keyType.GetMembers() {
+		for j := range columns {
+			if columns[j].GetName() == member.GetName() && !proto.Equal(row.GetItems()[j], key.GetItems()[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestReadRowsInto(t *testing.T) {
+	type row struct {
+		Tenant string  `sql:"tenant"`
+		ID     uint64  `sql:"id"`
+		Title  *string `sql:"title"`
+	}
+
+	title := func(s string) *string {
+		return &s
+	}
+
+	t.Run("CompositeKey", func(t *testing.T) {
+		type key struct {
+			Tenant string `sql:"tenant"`
+			ID     int    `sql:"id"`
+		}
+		c := &testReadRowsClient{
+			desc: options.Description{
+				Columns: []options.Column{
+					{Name: "tenant", Type: types.Optional(types.TypeText)},
+					{Name: "id", Type: types.Optional(types.TypeUint64)},
+					{Name: "title", Type: types.Optional(types.TypeText)},
+					{Name: "created", Type: types.Optional(types.TypeTimestamp)},
+				},
+				PrimaryKey: []string{"tenant", "id"},
+			},
+		}
+		for _, r := range []row{{"a", 1, title("a1")}, {"a", 2, nil}, {"b", 1, title("b1")}, {"b", 3, title("b3")}} {
+			fields := []value.StructValueField{
+				{Name: "tenant", V: value.OptionalValue(value.TextValue(r.Tenant))},
+				{Name: "id", V: value.OptionalValue(value.Uint64Value(r.ID))},
+				{Name: "title", V: value.NullValue(types.TypeText)},
+				{Name: "created", V: value.NullValue(types.TypeTimestamp)},
+			}
+			if r.Title != nil {
+				fields[2].V = value.OptionalValue(value.TextValue(*r.Title))
+			}
+			c.rows = append(c.rows, value.StructValue(fields...))
+		}
+
+		rows, missing, err := table.ReadRowsInto[row](context.Background(), c, "/local/t",
+			[]key{{"b", 3}, {"a", 1}, {"c", 1}, {"a", 2}, {"b", 2}, {"a", 1}},
+			table.WithReadRowsBatchSize(2),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []row{{"b", 3, title("b3")}, {"a", 1, title("a1")}, {"a", 2, nil}, {"a", 1, title("a1")}}, rows)
+		require.Equal(t, []key{{"c", 1}, {"b", 2}}, missing)
+		require.ElementsMatch(t, []int{2, 2, 1}, c.batches)
+	})
+	t.Run("ScalarKey", func(t *testing.T) {
+		c := &testReadRowsClient{
+			desc: options.Description{
+				Columns: []options.Column{
+					{Name: "id", Type: types.TypeUint64},
+					{Name: "tenant", Type: types.TypeText},
+				},
+				PrimaryKey: []string{"id"},
+			},
+			rows: []value.Value{
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+					value.StructValueField{Name: "tenant", V: value.TextValue("a")},
+				),
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+					value.StructValueField{Name: "tenant", V: value.TextValue("b")},
+				),
+			},
+		}
+		type tenant struct {
+			Tenant string `sql:"tenant"`
+		}
+		rows, missing, err := table.ReadRowsInto[tenant](context.Background(), c, "/local/t", []uint32{2, 3, 1})
+		require.NoError(t, err)
+		require.Equal(t, []tenant{{Tenant: "b"}, {Tenant: "a"}}, rows)
+		require.Equal(t, []uint32{3}, missing)
+	})
+	t.Run("ReadColumnsWithoutKey", func(t *testing.T) {
+		c := &testReadRowsClient{
+			desc: options.Description{
+				Columns: []options.Column{
+					{Name: "id", Type: types.TypeUint64},
+					{Name: "tenant", Type: types.TypeText},
+					{Name: "title", Type: types.TypeText},
+				},
+				PrimaryKey: []string{"id"},
+			},
+			rows: []value.Value{
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+					value.StructValueField{Name: "tenant", V: value.TextValue("a")},
+					value.StructValueField{Name: "title", V: value.TextValue("a1")},
+				),
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+					value.StructValueField{Name: "tenant", V: value.TextValue("b")},
+					value.StructValueField{Name: "title", V: value.TextValue("b2")},
+				),
+			},
+		}
+		type tenant struct {
+			Tenant string `sql:"tenant"`
+		}
+		rows, missing, err := table.ReadRowsInto[tenant](context.Background(), c, "/local/t", []uint64{2, 3, 1},
+			table.WithReadRowsOptions(options.ReadColumn("tenant")),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []tenant{{Tenant: "b"}, {Tenant: "a"}}, rows)
+		require.Equal(t, []uint64{3}, missing)
+	})
+	t.Run("Errors", func(t *testing.T) {
+		c := &testReadRowsClient{
+			desc: options.Description{
+				Columns: []options.Column{
+					{Name: "tenant", Type: types.TypeText},
+					{Name: "id", Type: types.TypeUint64},
+				},
+				PrimaryKey: []string{"tenant", "id"},
+			},
+		}
+		_, _, err := table.ReadRowsInto[row](context.Background(), c, "/local/t", []uint64{1})
+		require.Error(t, err)
+		_, _, err = table.ReadRowsInto[row](context.Background(), c, "/local/t", []struct {
+			ID uint64 `sql:"id"`
+		}{{ID: 1}})
+		require.Error(t, err)
+		_, _, err = table.ReadRowsInto[row](context.Background(), c, "/local/t", []struct {
+			Tenant string `sql:"tenant"`
+			ID     int    `sql:"id"`
+		}{{Tenant: "a", ID: -1}})
+		require.Error(t, err)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		rows, missing, err := table.ReadRowsInto[row](ctx, &testReadRowsClient{}, "/local/t", []uint64(nil))
+		require.NoError(t, err)
+		require.Empty(t, rows)
+		require.Empty(t, missing)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
@@ -20,6 +21,9 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+// PoolStats is a snapshot of session pool stats
+type PoolStats = stats.Stats
+
 // Operation is the interface that holds an operation for retry.
 // if Operation returns not nil - operation will retry
 // if Operation returns nil - retry loop will break
@@ -78,6 +82,26 @@ type Client interface {
 	BulkUpsert(ctx context.Context, table string, data BulkUpsertData, opts ...Option) error
 }
 
+// PoolStatsProvider is an optional interface of Client for observing of session pool.
+// Table client of ydb.Driver implements PoolStatsProvider
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PoolStatsProvider interface {
+	// Stats returns snapshot of session pool stats
+	Stats() PoolStats
+}
+
+// ReadinessWaiter is an optional interface of Client for waiting of warm session pool.
+// Table client of ydb.Driver implements ReadinessWaiter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ReadinessWaiter interface {
+	// WaitReady waits until session pool prefills minimum count of sessions
+	// (see ydb.WithSessionPoolMinSize) or context is done.
+	// WaitReady returns immediately if minimum size of session pool is not defined
+	WaitReady(ctx context.Context) error
+}
+
 type SessionStatus = string
 
 const (
@@ -269,6 +293,22 @@ type TransactionActor interface {
 	) (result.Result, error)
 }
 
+// TransactionHooks is an optional interface of TransactionActor for callbacks on completion of transaction.
+// Transactions of table client of ydb.Driver implement TransactionHooks
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TransactionHooks interface {
+	// OnCommit registers callback which will be called after successful commit of transaction.
+	// Callbacks are bound to the transaction: if DoTx retries operation, callbacks registered
+	// within failed attempt are not called on commit of next attempt
+	OnCommit(f func())
+
+	// OnRollback registers callback which will be called if transaction was rolled back or
+	// commit of transaction failed. Callback receives the reason: commit error, error of
+	// failed DoTx attempt or rolling back error on explicit rollback
+	OnRollback(f func(err error))
+}
+
 type Transaction interface {
 	TransactionActor
 
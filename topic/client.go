@@ -54,6 +54,12 @@ type Client interface {
 	// it is fast non block call, connection starts in background
 	StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error)
 
+	// StartMultiWriter start writer to all partitions of topic with routing of messages by key
+	// it is fast non block call, partitions described and connections started at first write
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StartMultiWriter(topicPath string, opts ...topicoptions.MultiWriterOption) (*topicwriter.MultiWriter, error)
+
 	// StartTransactionalWriter start writer for write messages within transaction
 	//
 	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
@@ -28,6 +28,42 @@ func AlterWithPartitionCountLimit(partitionCountLimit int64) AlterOption {
 	return withPartitionCountLimit(partitionCountLimit)
 }
 
+// AlterWithMaxActivePartitions change max active partitions of the topic for auto partitioning
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithMaxActivePartitions(count int64) AlterOption {
+	return withMaxActivePartitions(count)
+}
+
+// AlterWithAutoPartitioningStrategy change strategy of split/merge partitions of the topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningStrategy(strategy topictypes.AutoPartitioningStrategy) AlterOption {
+	return withAutoPartitioningStrategy(strategy)
+}
+
+// AlterWithAutoPartitioningStabilizationWindow change duration of partition write speed measurement
+// before split/merge of the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningStabilizationWindow(window time.Duration) AlterOption {
+	return withAutoPartitioningStabilizationWindow(window)
+}
+
+// AlterWithAutoPartitioningUpUtilizationPercent change percent of partition write speed limit for split the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningUpUtilizationPercent(percent int32) AlterOption {
+	return withAutoPartitioningUpUtilizationPercent(percent)
+}
+
+// AlterWithAutoPartitioningDownUtilizationPercent change percent of partition write speed limit for merge partitions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningDownUtilizationPercent(percent int32) AlterOption {
+	return withAutoPartitioningDownUtilizationPercent(percent)
+}
+
 // AlterWithRetentionPeriod change retention period of topic
 func AlterWithRetentionPeriod(retentionPeriod time.Duration) AlterOption {
 	return withRetentionPeriod(retentionPeriod)
@@ -28,6 +28,42 @@ func CreateWithPartitionCountLimit(count int64) CreateOption {
 	return withPartitionCountLimit(count)
 }
 
+// CreateWithMaxActivePartitions set max active partitions of the topic for auto partitioning
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithMaxActivePartitions(count int64) CreateOption {
+	return withMaxActivePartitions(count)
+}
+
+// CreateWithAutoPartitioningStrategy set strategy of split/merge partitions of the topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningStrategy(strategy topictypes.AutoPartitioningStrategy) CreateOption {
+	return withAutoPartitioningStrategy(strategy)
+}
+
+// CreateWithAutoPartitioningStabilizationWindow set duration of partition write speed measurement
+// before split/merge of the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningStabilizationWindow(window time.Duration) CreateOption {
+	return withAutoPartitioningStabilizationWindow(window)
+}
+
+// CreateWithAutoPartitioningUpUtilizationPercent set percent of partition write speed limit for split the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningUpUtilizationPercent(percent int32) CreateOption {
+	return withAutoPartitioningUpUtilizationPercent(percent)
+}
+
+// CreateWithAutoPartitioningDownUtilizationPercent set percent of partition write speed limit for merge partitions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningDownUtilizationPercent(percent int32) CreateOption {
+	return withAutoPartitioningDownUtilizationPercent(percent)
+}
+
 // CreateWithRetentionPeriod set retention time interval for the topic.
 func CreateWithRetentionPeriod(retentionPeriod time.Duration) CreateOption {
 	return withRetentionPeriod(retentionPeriod)
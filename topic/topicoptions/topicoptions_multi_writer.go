@@ -0,0 +1,46 @@
+package topicoptions
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+)
+
+type (
+	// MultiWriterOption options for a topic multi writer
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	MultiWriterOption = topicwriterinternal.PublicMultiWriterOption
+
+	// KeyHasher calculates hash of the message key for choose partition
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	KeyHasher = topicwriterinternal.PublicKeyHasher
+)
+
+// WithMultiWriterWriterOptions set options of writers for every partition.
+// Producer id (if set) used as prefix of producer ids of partition writers, partition options are ignored.
+// Max queue length (WithWriterMaxQueueLen) is shared between all partitions.
+// Persistent queue (WithWriterPersistentQueue) of every partition is stored in subdirectory with partition id.
+// Unacked messages of all subdirectories are sent on start of multi writer, even if the partition is not active now.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMultiWriterWriterOptions(opts ...WriterOption) MultiWriterOption {
+	return topicwriterinternal.WithMultiWriterWriterOptions(opts...)
+}
+
+// WithMultiWriterKeyHasher set hasher of message keys. Partition of message chosen by rendezvous hashing
+// of hash(key) and partition ids of active partitions. Default hasher is FNV-1a 64 bit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMultiWriterKeyHasher(hasher KeyHasher) MultiWriterOption {
+	return topicwriterinternal.WithMultiWriterKeyHasher(hasher)
+}
+
+// WithMultiWriterPartitionsRefreshPeriod set period of check topic partitions for recompute routing.
+// Default is one minute, zero disables refresh.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMultiWriterPartitionsRefreshPeriod(period time.Duration) MultiWriterOption {
+	return topicwriterinternal.WithMultiWriterPartitionsRefreshPeriod(period)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topiccodecs"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
@@ -171,6 +172,14 @@ func WithAddDecoder(codec topictypes.Codec, decoderCreate CreateDecoderFunc) Rea
 	}
 }
 
+// WithReaderZstdMaxDecodedSize set limit of decoded message size for built-in zstd codec
+// (default 50MiB). Reader fails on message with greater decoded size.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderZstdMaxDecodedSize(size uint64) ReaderOption {
+	return WithAddDecoder(topictypes.CodecZstd, topiccodecs.NewZstdDecoder(size))
+}
+
 // CommitMode variants of commit mode of the reader
 type CommitMode = topicreadercommon.PublicCommitMode
 
@@ -281,3 +290,15 @@ func WithReaderWithoutConsumer(saveStateOnReconnection bool) ReaderOption {
 		cfg.CommitMode = CommitModeNone
 	}
 }
+
+// WithReaderSupportSplitMergePartitions set support of split and merge partitions on the client side.
+// The reader finishes read (and commit for reader with commits) messages of parent partitions before start
+// read children partitions, it keeps order of messages with same message group id.
+// Default is true.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderSupportSplitMergePartitions(enableSupport bool) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.SupportSplitMergePartitions = enableSupport
+	}
+}
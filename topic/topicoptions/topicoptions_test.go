@@ -5,6 +5,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 func TestEqualAlterOptions(t *testing.T) {
@@ -98,3 +103,58 @@ func TestEqualCreateOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoPartitioningOptions(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		var req rawtopic.CreateTopicRequest
+		for _, opt := range []CreateOption{
+			CreateWithMinActivePartitions(1),
+			CreateWithMaxActivePartitions(10),
+			CreateWithAutoPartitioningStrategy(topictypes.AutoPartitioningStrategyScaleUp),
+			CreateWithAutoPartitioningStabilizationWindow(time.Minute),
+			CreateWithAutoPartitioningUpUtilizationPercent(80),
+			CreateWithAutoPartitioningDownUtilizationPercent(20),
+		} {
+			opt.ApplyCreateOption(&req)
+		}
+		proto := req.ToProto().GetPartitioningSettings()
+		require.Equal(t, int64(1), proto.GetMinActivePartitions())
+		require.Equal(t, int64(10), proto.GetMaxActivePartitions())
+		require.Equal(t,
+			Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_SCALE_UP,
+			proto.GetAutoPartitioningSettings().GetStrategy(),
+		)
+		writeSpeed := proto.GetAutoPartitioningSettings().GetPartitionWriteSpeed()
+		require.Equal(t, time.Minute, writeSpeed.GetStabilizationWindow().AsDuration())
+		require.Equal(t, int32(80), writeSpeed.GetUpUtilizationPercent())
+		require.Equal(t, int32(20), writeSpeed.GetDownUtilizationPercent())
+	})
+	t.Run("AlterStrategyOnly", func(t *testing.T) {
+		var req rawtopic.AlterTopicRequest
+		AlterWithAutoPartitioningStrategy(topictypes.AutoPartitioningStrategyPaused).ApplyAlterOption(&req)
+		proto := req.ToProto().GetAlterPartitioningSettings()
+		require.Nil(t, proto.SetMaxActivePartitions)
+		require.Equal(t,
+			Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_PAUSED,
+			proto.GetAlterAutoPartitioningSettings().GetSetStrategy(),
+		)
+		require.Nil(t, proto.GetAlterAutoPartitioningSettings().GetSetPartitionWriteSpeed())
+	})
+	t.Run("AlterWriteSpeed", func(t *testing.T) {
+		var req rawtopic.AlterTopicRequest
+		for _, opt := range []AlterOption{
+			AlterWithMaxActivePartitions(5),
+			AlterWithAutoPartitioningStabilizationWindow(time.Second),
+			AlterWithAutoPartitioningUpUtilizationPercent(90),
+		} {
+			opt.ApplyAlterOption(&req)
+		}
+		proto := req.ToProto().GetAlterPartitioningSettings()
+		require.Equal(t, int64(5), proto.GetSetMaxActivePartitions())
+		require.Nil(t, proto.GetAlterAutoPartitioningSettings().SetStrategy)
+		writeSpeed := proto.GetAlterAutoPartitioningSettings().GetSetPartitionWriteSpeed()
+		require.Equal(t, time.Second, writeSpeed.GetSetStabilizationWindow().AsDuration())
+		require.Equal(t, int32(90), writeSpeed.GetSetUpUtilizationPercent())
+		require.Nil(t, writeSpeed.SetDownUtilizationPercent)
+	})
+}
@@ -25,6 +25,14 @@ func WithWriterAddEncoder(codec topictypes.Codec, f CreateEncoderFunc) WriterOpt
 	return topicwriterinternal.WithAddEncoder(rawtopiccommon.Codec(codec), f)
 }
 
+// WithWriterZstdLevel set compression level of built-in zstd codec in terms of zstd (1-22, default 3).
+// Levels mapped to supported levels: fastest (1-2), default (3-5), better (6-9) and best (10+).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWriterZstdLevel(level int) WriterOption {
+	return topicwriterinternal.WithZstdLevel(level)
+}
+
 // WithWriterCheckRetryErrorFunction can override default error retry policy
 // use CheckErrorRetryDecisionDefault for use default behavior for the error
 // callback func must be fast and deterministic: always result same result for same error - it can be called
@@ -103,6 +111,22 @@ func WithWriterPartitionID(partitionID int64) WriterOption {
 	return topicwriterinternal.WithPartitioning(topicwriterinternal.NewPartitioningWithPartitionID(partitionID))
 }
 
+// WithWriterPersistentQueue enables on-disk write-ahead queue of messages in dir.
+// Write saves messages to the queue (with fsync) before return, messages are removed from the queue
+// after ack from server. Unacked messages from the queue are sent again when writer starts with the same dir,
+// server skips already written messages by producer id and seqno. So the option needs stable producer id
+// (WithWriterProducerID) and one writer per dir.
+//
+// Messages are kept in memory until ack too, max count of them is limited by WithWriterMaxQueueLen.
+// The option is not supported for transactional writer.
+// Partially written last record of the queue (after crash) is dropped on start,
+// other corrupted records fail start of the writer.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWriterPersistentQueue(dir string) WriterOption {
+	return topicwriterinternal.WithPersistentQueue(dir)
+}
+
 // WithSyncWrite
 //
 // Deprecated: was experimental and not actual now.
@@ -18,3 +18,7 @@ var ErrConcurrencyCall = xerrors.Wrap(errors.New("ydb: concurrency call denied")
 // ErrCommitToExpiredSession it is not fatal error and reader can continue work
 // client side must check error with errors.Is
 var ErrCommitToExpiredSession = topicreadercommon.PublicErrCommitSessionToExpiredSession
+
+// ErrPartitionSessionExpired return if partition session for Seek, Pause or Resume not found or stopped
+// client side must check error with errors.Is
+var ErrPartitionSessionExpired = topicreadercommon.PublicErrPartitionSessionExpired
@@ -3,6 +3,7 @@ package topicreader
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
@@ -16,6 +17,8 @@ import (
 //
 // In other words you can have one goroutine for read messages and one goroutine for commit messages.
 //
+// Seek, SeekToTime, Pause and Resume can be called concurrency with other methods, except Close.
+//
 // Concurrency table
 // | Method           | ReadMessage | ReadMessageBatch | Commit | Close |
 // | ReadMessage      |      -      |         -        |   +    | -     |
@@ -123,6 +126,57 @@ func (r *Reader) PopMessagesBatchTx(
 	return r.reader.PopBatchTx(ctx, internalTx, opts...)
 }
 
+// Seek moves read position of the partition session to the offset.
+// Partition session id is available from Message.PartitionSessionID and Batch.PartitionSessionID.
+//
+// The protocol allows set read offset on start of partition session only, so Seek restarts
+// connection to the server. Uncommitted messages of all partitions of the reader will be received again
+// and partition sessions get new ids.
+//
+// Reader with consumer can seek forward only: messages before the offset will be committed.
+// Seek before committed offset is allowed for reader without consumer (topicoptions.WithReaderWithoutConsumer).
+//
+// Seek returns ErrPartitionSessionExpired if the partition session was stopped.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Seek(ctx context.Context, partitionSessionID, offset int64) error {
+	return r.reader.Seek(ctx, partitionSessionID, offset)
+}
+
+// SeekToTime skips messages written before readFrom for all partitions of the reader, include
+// partitions, which will be started later. Server starts read of partition from maximum of
+// committed offset and the first offset written at readFrom or later.
+//
+// SeekToTime restarts connection to the server as Seek.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) SeekToTime(ctx context.Context, readFrom time.Time) error {
+	return r.reader.SeekToTime(ctx, readFrom)
+}
+
+// Pause stops receive messages of the partition session, while other partitions keep flowing.
+//
+// The server keeps sending messages of paused partition, the reader stores them in memory without hold space
+// of the reader buffer (topicoptions.WithReaderBufferSizeBytes), up to one more buffer size for all paused partitions.
+// Messages above the limit hold the reader buffer as usual.
+// The partition keeps paused after reconnect and seek, partition session id may be changed.
+// Graceful stop of paused partition session by the server waits until resume.
+//
+// Pause returns ErrPartitionSessionExpired if the partition session was stopped.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Pause(ctx context.Context, partitionSessionID int64) error {
+	return r.reader.Pause(ctx, partitionSessionID)
+}
+
+// Resume continues receive messages of partition session, paused by Pause.
+// It accepts partition session id, which was passed to Pause, even if the session was changed by reconnect.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Resume(ctx context.Context, partitionSessionID int64) error {
+	return r.reader.Resume(ctx, partitionSessionID)
+}
+
 // CommitRangeGetter interface for get commit offsets
 type CommitRangeGetter = topicreadercommon.PublicCommitRangeGetter
 
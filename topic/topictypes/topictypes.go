@@ -20,7 +20,7 @@ const (
 	// CodecLzop not supported by default, customer need provide own codec library
 	CodecLzop = Codec(rawtopiccommon.CodecLzop)
 
-	// CodecZstd not supported by default, customer need provide own codec library
+	// CodecZstd supported by default, level of compression may be set by topicoptions.WithWriterZstdLevel
 	CodecZstd = Codec(rawtopiccommon.CodecZstd)
 
 	CodecCustomerFirst = Codec(rawtopiccommon.CodecCustomerFirst)
@@ -97,18 +97,90 @@ func (m *MeteringMode) ToRaw(raw *rawtopic.MeteringMode) {
 type PartitionSettings struct {
 	MinActivePartitions int64
 	PartitionCountLimit int64
+
+	// MaxActivePartitions is upper limit of active partitions count for auto partitioning
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	MaxActivePartitions int64
+
+	// AutoPartitioningSettings contains strategy of split/merge partitions of the topic
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	AutoPartitioningSettings AutoPartitioningSettings
 }
 
 // ToRaw convert public format to internal. Used internally only.
 func (s *PartitionSettings) ToRaw(raw *rawtopic.PartitioningSettings) {
 	raw.MinActivePartitions = s.MinActivePartitions
+	raw.MaxActivePartitions = s.MaxActivePartitions
 	raw.PartitionCountLimit = s.PartitionCountLimit
+	s.AutoPartitioningSettings.ToRaw(&raw.AutoPartitioningSettings)
 }
 
 // FromRaw convert internal format to public. Used internally only.
 func (s *PartitionSettings) FromRaw(raw *rawtopic.PartitioningSettings) {
 	s.MinActivePartitions = raw.MinActivePartitions
+	s.MaxActivePartitions = raw.MaxActivePartitions
 	s.PartitionCountLimit = raw.PartitionCountLimit
+	s.AutoPartitioningSettings.FromRaw(&raw.AutoPartitioningSettings)
+}
+
+// AutoPartitioningStrategy is strategy of split/merge partitions of the topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type AutoPartitioningStrategy int32
+
+const (
+	AutoPartitioningStrategyUnspecified    = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyUnspecified)
+	AutoPartitioningStrategyDisabled       = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyDisabled)
+	AutoPartitioningStrategyScaleUp        = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyScaleUp)
+	AutoPartitioningStrategyScaleUpAndDown = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyScaleUpAndDown)
+	AutoPartitioningStrategyPaused         = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyPaused)
+)
+
+// AutoPartitioningSettings contains settings of split/merge partitions of the topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type AutoPartitioningSettings struct {
+	AutoPartitioningStrategy           AutoPartitioningStrategy
+	AutoPartitioningWriteSpeedStrategy AutoPartitioningWriteSpeedStrategy
+}
+
+// ToRaw convert public format to internal. Used internally only.
+func (s *AutoPartitioningSettings) ToRaw(raw *rawtopic.AutoPartitioningSettings) {
+	raw.AutoPartitioningStrategy = rawtopic.AutoPartitioningStrategy(s.AutoPartitioningStrategy)
+	s.AutoPartitioningWriteSpeedStrategy.ToRaw(&raw.AutoPartitioningWriteSpeedStrategy)
+}
+
+// FromRaw convert internal format to public. Used internally only.
+func (s *AutoPartitioningSettings) FromRaw(raw *rawtopic.AutoPartitioningSettings) {
+	s.AutoPartitioningStrategy = AutoPartitioningStrategy(raw.AutoPartitioningStrategy)
+	s.AutoPartitioningWriteSpeedStrategy.FromRaw(&raw.AutoPartitioningWriteSpeedStrategy)
+}
+
+// AutoPartitioningWriteSpeedStrategy contains thresholds of partition write speed for split/merge partitions.
+// Partition splits if write speed more than UpUtilizationPercent of partition write speed limit
+// during StabilizationWindow and merges if it less than DownUtilizationPercent.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type AutoPartitioningWriteSpeedStrategy struct {
+	StabilizationWindow    time.Duration
+	UpUtilizationPercent   int32
+	DownUtilizationPercent int32
+}
+
+// ToRaw convert public format to internal. Used internally only.
+func (s *AutoPartitioningWriteSpeedStrategy) ToRaw(raw *rawtopic.AutoPartitioningWriteSpeedStrategy) {
+	raw.StabilizationWindow = s.StabilizationWindow
+	raw.UpUtilizationPercent = s.UpUtilizationPercent
+	raw.DownUtilizationPercent = s.DownUtilizationPercent
+}
+
+// FromRaw convert internal format to public. Used internally only.
+func (s *AutoPartitioningWriteSpeedStrategy) FromRaw(raw *rawtopic.AutoPartitioningWriteSpeedStrategy) {
+	s.StabilizationWindow = raw.StabilizationWindow
+	s.UpUtilizationPercent = raw.UpUtilizationPercent
+	s.DownUtilizationPercent = raw.DownUtilizationPercent
 }
 
 // TopicDescription contains info about topic.
@@ -169,6 +241,20 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+
+	// KeyRange contains range of message group keys of the partition for topics with auto partitioning
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	KeyRange PartitionKeyRange
+}
+
+// PartitionKeyRange contains bounds of message group keys of the partition.
+// Empty bound means unbounded range from the side.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PartitionKeyRange struct {
+	FromBound []byte
+	ToBound   []byte
 }
 
 // FromRaw convert from internal format to public. Used internally only.
@@ -178,6 +264,8 @@ func (p *PartitionInfo) FromRaw(raw *rawtopic.PartitionInfo) {
 
 	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
 	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+	p.KeyRange.FromBound = clone.ByteSlice(raw.KeyRange.FromBound)
+	p.KeyRange.ToBound = clone.ByteSlice(raw.KeyRange.ToBound)
 }
 
 type MultipleWindowsStat struct {
@@ -52,7 +52,16 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 				Path: "some/path",
 				PartitionSettings: PartitionSettings{
 					MinActivePartitions: 4,
+					MaxActivePartitions: 8,
 					PartitionCountLimit: 4,
+					AutoPartitioningSettings: AutoPartitioningSettings{
+						AutoPartitioningStrategy: AutoPartitioningStrategyScaleUp,
+						AutoPartitioningWriteSpeedStrategy: AutoPartitioningWriteSpeedStrategy{
+							StabilizationWindow:    time.Minute,
+							UpUtilizationPercent:   80,
+							DownUtilizationPercent: 20,
+						},
+					},
 				},
 				Partitions: []PartitionInfo{
 					{
@@ -74,6 +83,10 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 						ParentPartitionIDs: []int64{
 							47,
 						},
+						KeyRange: PartitionKeyRange{
+							FromBound: []byte("a"),
+							ToBound:   []byte("b"),
+						},
 					},
 				},
 				RetentionPeriod:    time.Hour,
@@ -92,7 +105,16 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 				},
 				PartitioningSettings: rawtopic.PartitioningSettings{
 					MinActivePartitions: 4,
+					MaxActivePartitions: 8,
 					PartitionCountLimit: 4,
+					AutoPartitioningSettings: rawtopic.AutoPartitioningSettings{
+						AutoPartitioningStrategy: rawtopic.AutoPartitioningStrategyScaleUp,
+						AutoPartitioningWriteSpeedStrategy: rawtopic.AutoPartitioningWriteSpeedStrategy{
+							StabilizationWindow:    time.Minute,
+							UpUtilizationPercent:   80,
+							DownUtilizationPercent: 20,
+						},
+					},
 				},
 				Partitions: []rawtopic.PartitionInfo{
 					{
@@ -114,6 +136,10 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 						ParentPartitionIDs: []int64{
 							47,
 						},
+						KeyRange: rawtopic.PartitionKeyRange{
+							FromBound: []byte("a"),
+							ToBound:   []byte("b"),
+						},
 					},
 				},
 				RetentionPeriod:    time.Hour,
@@ -0,0 +1,56 @@
+package topicwriter
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+)
+
+// MultiWriter writes messages to all active partitions of topic with one internal writer per partition.
+// Messages routed to partitions by hash of user key, so messages with the same key written to the same
+// partition and keep order. All internal writers share one queue limit (see topicoptions.WithWriterMaxQueueLen).
+//
+// Partition of the key chosen by rendezvous hashing, so change of partitions moves only a part of keys:
+// when partitions added, only keys chosen for new partitions (about 1/N of keys, N - new count of partitions)
+// move to them; when partitions removed, only keys of removed partitions move to other partitions.
+//
+// MultiWriter refreshes list of partitions periodically. Before routing change it waits acks for written messages
+// of partitions, which may lose keys (all partitions when partitions added, removed partitions only otherwise),
+// so messages with the same key keep order after the change.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type MultiWriter struct {
+	inner *topicwriterinternal.MultiWriter
+}
+
+// NewMultiWriter create new multi writer from internal type. Used internally only.
+func NewMultiWriter(writer *topicwriterinternal.MultiWriter) *MultiWriter {
+	return &MultiWriter{
+		inner: writer,
+	}
+}
+
+// Write send messages with the key to partition, chosen by hash of the key.
+// The semantic of the call is the same as Writer.Write.
+//
+// First call of Write waits describe of topic partitions.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *MultiWriter) Write(ctx context.Context, key string, messages ...Message) error {
+	return w.inner.Write(ctx, key, messages)
+}
+
+// Flush waits till all in-flight messages of all partitions are acknowledged.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *MultiWriter) Flush(ctx context.Context) error {
+	return w.inner.Flush(ctx)
+}
+
+// Close will flush rested messages from buffers and close writers of all partitions.
+// You can't write new messages after call Close
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *MultiWriter) Close(ctx context.Context) error {
+	return w.inner.Close(ctx)
+}